@@ -0,0 +1,339 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/argio"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/spf13/cobra"
+)
+
+// maxPages bounds --paginate so a server that keeps returning a token can't
+// loop forever.
+const maxPages = 1000
+
+type apiCmdOptions struct {
+	body       string
+	headers    []string
+	index      string
+	apiVersion string
+	paginate   bool
+}
+
+func NewAPICmd() *cobra.Command {
+	options := apiCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "api <method> <path>",
+		Short: "Send an authenticated request to the Pinecone API",
+		Long: help.Long(`
+			Send a raw request to the Pinecone API using the CLI's credentials, for
+			endpoints that don't have a dedicated command yet.
+
+			Paths are sent to the control plane of the configured environment.
+			Paths starting with /admin/ go to the admin API, which requires a user
+			login or service account. With --index, the path is sent to that index's
+			data plane host instead.
+
+			Requests authenticate the same way other commands do: with the default
+			API key when one is configured, otherwise with the login or service
+			account token and the target project. The response is printed as JSON.
+
+			With --paginate, GET requests follow pagination.next tokens and the
+			list fields from every page are merged into a single response.
+		`),
+		Example: help.Examples(`
+		    pc api GET /indexes
+		    pc api GET /collections --api-version 2025-04
+		    pc api POST /indexes --body ./index.json
+		    pc api GET /admin/projects
+		    pc api GET /vectors/list --index my-index --paginate
+		    pc api POST /query --index my-index --body '{"topK": 3, "id": "doc-1"}'
+		`),
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+
+			resp, err := runAPI(ctx, strings.ToUpper(args[0]), args[1], options)
+			if err != nil {
+				msg.FailMsg("Request failed: %s", err)
+				exit.Error(err, "Request failed")
+			}
+
+			out := resp.body
+			if resp.json {
+				out = indentJSON(out)
+			}
+			os.Stdout.Write(out)
+			if len(out) > 0 && !bytes.HasSuffix(out, []byte("\n")) {
+				fmt.Fprintln(os.Stdout)
+			}
+
+			if resp.status >= 400 {
+				err := &pinecone.PineconeError{Code: resp.status, Msg: errors.New(http.StatusText(resp.status))}
+				msg.FailMsg("Request failed with status %d %s", resp.status, http.StatusText(resp.status))
+				exit.Error(err, "Request failed")
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.body, "body", "", "Request body as inline JSON, a ./path.json file, or '-' for stdin")
+	cmd.Flags().StringArrayVarP(&options.headers, "header", "H", []string{}, "Extra request header as 'Name: value' (repeatable)")
+	cmd.Flags().StringVar(&options.index, "index", "", "Send the request to this index's data plane host")
+	cmd.Flags().StringVar(&options.apiVersion, "api-version", sdk.APIVersion, "Value of the X-Pinecone-Api-Version header")
+	cmd.Flags().BoolVar(&options.paginate, "paginate", false, "Follow pagination tokens and merge every page (GET only)")
+
+	return cmd
+}
+
+type apiResponse struct {
+	status int
+	body   []byte
+	json   bool
+}
+
+func runAPI(ctx context.Context, method, path string, options apiCmdOptions) (*apiResponse, error) {
+	if options.paginate && method != http.MethodGet {
+		return nil, fmt.Errorf("--paginate only applies to GET requests")
+	}
+
+	var body []byte
+	if options.body != "" {
+		b, _, err := argio.ReadAll(options.body)
+		if err != nil {
+			return nil, err
+		}
+		body = b
+	}
+
+	extra, err := parseHeaders(options.headers)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := options.index == "" && isAdminPath(path)
+	baseURL := sdk.APIHostURL()
+	if local.Enabled() {
+		baseURL = local.Host()
+	}
+	if options.index != "" {
+		pc := sdk.NewPineconeClient(ctx)
+		index, err := sdk.DescribeIndex(ctx, pc, options.index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe index %s: %w", style.Emphasis(options.index), err)
+		}
+		baseURL = sdk.IndexHostURL(index)
+	}
+
+	target, err := buildURL(baseURL, path)
+	if err != nil {
+		return nil, err
+	}
+
+	auth, err := sdk.APIAuthHeaders(ctx, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	client := sdk.RestClient()
+	send := func(u string) (*apiResponse, error) {
+		req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		for name, values := range auth {
+			req.Header[name] = values
+		}
+		req.Header.Set("Accept", "application/json")
+		if body != nil {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		if options.apiVersion != "" {
+			req.Header.Set("X-Pinecone-Api-Version", options.apiVersion)
+		}
+		for name, values := range extra {
+			req.Header[name] = values
+		}
+
+		log.Debug().Str("method", method).Str("url", u).Msg("Sending API request")
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		b, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return &apiResponse{
+			status: resp.StatusCode,
+			body:   b,
+			json:   strings.Contains(resp.Header.Get("Content-Type"), "json") || json.Valid(b),
+		}, nil
+	}
+
+	resp, err := send(target)
+	if err != nil || !options.paginate {
+		return resp, err
+	}
+
+	merged, token, err := firstPage(resp)
+	if err != nil || token == "" {
+		return resp, err
+	}
+	for page := 1; token != ""; page++ {
+		if page >= maxPages {
+			return nil, fmt.Errorf("stopped after %d pages", maxPages)
+		}
+		next, err := send(withPaginationToken(target, token))
+		if err != nil {
+			return nil, err
+		}
+		if next.status >= 400 {
+			return next, nil
+		}
+		token, err = mergePage(merged, next.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	return &apiResponse{status: resp.status, body: b, json: true}, nil
+}
+
+// isAdminPath reports whether path belongs to the admin API, which only
+// accepts bearer tokens.
+func isAdminPath(path string) bool {
+	return path == "/admin" || strings.HasPrefix(path, "/admin/")
+}
+
+// buildURL joins path, which may include a query string, onto baseURL.
+func buildURL(baseURL, path string) (string, error) {
+	if strings.Contains(path, "://") {
+		return "", fmt.Errorf("path %q must be relative to the API host, such as /indexes", path)
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	base, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+	base.Path += ref.Path
+	base.RawQuery = ref.RawQuery
+	return base.String(), nil
+}
+
+func parseHeaders(headers []string) (http.Header, error) {
+	h := http.Header{}
+	for _, raw := range headers {
+		name, value, ok := strings.Cut(raw, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid header %q, expected 'Name: value'", raw)
+		}
+		h.Add(name, strings.TrimSpace(value))
+	}
+	return h, nil
+}
+
+func withPaginationToken(target, token string) string {
+	u, err := url.Parse(target)
+	if err != nil {
+		return target
+	}
+	q := u.Query()
+	q.Set("paginationToken", token)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// firstPage decodes the first page of a paginated response. Responses that
+// aren't JSON objects or have no next token are returned as a single page.
+func firstPage(resp *apiResponse) (map[string]any, string, error) {
+	if resp.status >= 400 || !resp.json {
+		return nil, "", nil
+	}
+	page, err := decodeObject(resp.body)
+	if err != nil {
+		return nil, "", nil
+	}
+	return page, takeNextToken(page), nil
+}
+
+// mergePage appends every list field of the page in body onto the matching
+// field of merged and returns the page's next token.
+func mergePage(merged map[string]any, body []byte) (string, error) {
+	page, err := decodeObject(body)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode page: %w", err)
+	}
+	token := takeNextToken(page)
+	for key, value := range page {
+		items, ok := value.([]any)
+		if !ok {
+			continue
+		}
+		existing, _ := merged[key].([]any)
+		merged[key] = append(existing, items...)
+	}
+	return token, nil
+}
+
+// takeNextToken removes the pagination field from page and returns its next
+// token, if any.
+func takeNextToken(page map[string]any) string {
+	pagination, ok := page["pagination"].(map[string]any)
+	delete(page, "pagination")
+	if !ok {
+		return ""
+	}
+	next, _ := pagination["next"].(string)
+	return next
+}
+
+func decodeObject(b []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var obj map[string]any
+	if err := dec.Decode(&obj); err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("response is not a JSON object")
+	}
+	return obj, nil
+}
+
+// indentJSON pretty prints b, returning it unchanged if it isn't valid JSON.
+func indentJSON(b []byte) []byte {
+	var out bytes.Buffer
+	if err := json.Indent(&out, b, "", "  "); err != nil {
+		return b
+	}
+	return out.Bytes()
+}
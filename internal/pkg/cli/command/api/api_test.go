@@ -0,0 +1,69 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildURL(t *testing.T) {
+	u, err := buildURL("https://api.pinecone.io/", "/indexes/foo?x=1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.pinecone.io/indexes/foo?x=1", u)
+
+	u, err = buildURL("http://localhost:5081", "describe_index_stats")
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:5081/describe_index_stats", u)
+
+	_, err = buildURL("https://api.pinecone.io", "https://evil.example.com/indexes")
+	assert.Error(t, err)
+}
+
+func Test_isAdminPath(t *testing.T) {
+	assert.True(t, isAdminPath("/admin/projects"))
+	assert.False(t, isAdminPath("/administrators"))
+	assert.False(t, isAdminPath("/indexes"))
+}
+
+func Test_parseHeaders(t *testing.T) {
+	h, err := parseHeaders([]string{"X-Foo: bar", "X-Foo:baz"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bar", "baz"}, h.Values("X-Foo"))
+
+	_, err = parseHeaders([]string{"missing-colon"})
+	assert.Error(t, err)
+}
+
+func Test_withPaginationToken(t *testing.T) {
+	assert.Equal(t, "https://h/vectors/list?limit=2&paginationToken=abc",
+		withPaginationToken("https://h/vectors/list?limit=2&paginationToken=old", "abc"))
+}
+
+func Test_mergePages(t *testing.T) {
+	first := &apiResponse{
+		status: 200,
+		json:   true,
+		body:   []byte(`{"namespace":"ns","vectors":[{"id":"a"}],"pagination":{"next":"t1"},"usage":{"readUnits":1}}`),
+	}
+	merged, token, err := firstPage(first)
+	require.NoError(t, err)
+	assert.Equal(t, "t1", token)
+	assert.NotContains(t, merged, "pagination")
+
+	token, err = mergePage(merged, []byte(`{"namespace":"ns","vectors":[{"id":"b"},{"id":"c"}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, token)
+
+	b, err := json.Marshal(merged)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"namespace":"ns","vectors":[{"id":"a"},{"id":"b"},{"id":"c"}],"usage":{"readUnits":1}}`, string(b))
+}
+
+func Test_firstPage_NotPaginated(t *testing.T) {
+	merged, token, err := firstPage(&apiResponse{status: 200, json: true, body: []byte(`[1,2]`)})
+	require.NoError(t, err)
+	assert.Nil(t, merged)
+	assert.Empty(t, token)
+}
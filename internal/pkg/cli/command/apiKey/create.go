@@ -3,6 +3,7 @@ package apiKey
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
@@ -126,6 +127,7 @@ func NewCreateApiKeyCmd() *cobra.Command {
 					ProjectId:      keyWithSecret.Key.ProjectId,
 					OrganizationId: targetOrgId,
 					Origin:         secrets.OriginUserCreated,
+					CreatedAt:      time.Now().UTC(),
 				})
 			}
 		},
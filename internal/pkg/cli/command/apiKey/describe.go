@@ -1,22 +1,19 @@
 package apiKey
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 )
 
 type describeAPIKeyOptions struct {
 	apiKeyID string
 	json     bool
+	output   presenters.OutputFormat
 }
 
 func NewDescribeAPIKeyCmd() *cobra.Command {
@@ -35,16 +32,13 @@ func NewDescribeAPIKeyCmd() *cobra.Command {
 
 			apiKey, err := ac.APIKey.Describe(cmd.Context(), options.apiKeyID)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe API key %s: %s\n", style.Emphasis(options.apiKeyID), err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe API key %s: %s\n", style.Emphasis(options.apiKeyID), err)
 				exit.Errorf(err, "Failed to describe API key %s", options.apiKeyID)
 			}
 
-			if options.json {
-				json := text.IndentJSON(apiKey)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
+			presenters.PrintOutput(options.output.WithJSON(options.json), apiKey, func() {
 				presenters.PrintDescribeAPIKeyTable(apiKey)
-			}
+			})
 		},
 	}
 
@@ -54,6 +48,7 @@ func NewDescribeAPIKeyCmd() *cobra.Command {
 
 	// optional flags
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	return cmd
 }
@@ -13,7 +13,6 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +20,8 @@ import (
 type listKeysCmdCmdOptions struct {
 	projectID string
 	json      bool
+	output    presenters.OutputFormat
+	list      presenters.ListOptions
 }
 
 func NewListKeysCmd() *cobra.Command {
@@ -47,14 +48,14 @@ func NewListKeysCmd() *cobra.Command {
 			if projId == "" {
 				projId, err = state.GetTargetProjectId()
 				if err != nil {
-					msg.FailJSON(options.json, "No target project set, and no project ID provided. Use %s to set the target project. Use %s to list keys for a specific project.", style.Code("pc target -o <org> -p <project>"), style.Code("pc api-key list -i <project-id>"))
+					msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "No target project set, and no project ID provided. Use %s to set the target project. Use %s to list keys for a specific project.", style.Code("pc target -o <org> -p <project>"), style.Code("pc api-key list -i <project-id>"))
 					exit.ErrorMsg("No project ID provided, and no target project set")
 				}
 			}
 
 			keysResponse, err := ac.APIKey.List(cmd.Context(), projId)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list API keys: %s", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list API keys: %s", err)
 				exit.Error(err, "Failed to list API keys")
 			}
 
@@ -64,33 +65,26 @@ func NewListKeysCmd() *cobra.Command {
 				return sortedKeys[i].Name < sortedKeys[j].Name
 			})
 
-			if options.json {
-				json := text.IndentJSON(sortedKeys)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
-				printTable(sortedKeys)
-			}
+			presenters.PrintList(options.output.WithJSON(options.json), options.list, sortedKeys, apiKeyTable(sortedKeys), printTable(projId))
 		},
 	}
 
 	cmd.Flags().StringVarP(&options.projectID, "id", "i", "", "ID of the project to list the keys for if not the target project")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
 	return cmd
 }
 
-func printTable(keys []*pinecone.APIKey) {
-	msg.InfoMsg("Organization: %s (ID: %s)", style.Emphasis(state.TargetOrg.Get().Name), style.Emphasis(state.TargetOrg.Get().Id))
-	msg.InfoMsg("Project: %s (ID: %s)", style.Emphasis(state.TargetProj.Get().Name), style.Emphasis(state.TargetProj.Get().Id))
-	msg.Blank()
-	fmt.Fprintln(os.Stderr, style.Heading("API Keys"))
-	msg.Blank()
-
-	writer := presenters.NewTabWriter()
-
-	columns := []string{"NAME", "ID", "PROJECT ID", "ROLES"}
-	header := strings.Join(columns, "\t") + "\n"
-	fmt.Fprint(writer, header)
-
+func apiKeyTable(keys []*pinecone.APIKey) presenters.Table {
+	table := presenters.Table{
+		Resource: "API keys",
+		Columns: []presenters.TableColumn{
+			{Header: "NAME"}, {Header: "ID"}, {Header: "PROJECT ID"}, {Header: "ROLES"},
+		},
+	}
 	for _, key := range keys {
 		values := []string{
 			key.Name,
@@ -98,8 +92,30 @@ func printTable(keys []*pinecone.APIKey) {
 			key.ProjectId,
 			strings.Join(key.Roles, ", "),
 		}
-		fmt.Fprint(writer, strings.Join(values, "\t")+"\n")
+		table.Rows = append(table.Rows, values)
+		table.Records = append(table.Records, key)
 	}
+	return table
+}
 
-	writer.Flush()
+// printTable prints the keys of projectID, the project that was queried,
+// which is only the target project when --id isn't given.
+func printTable(projectID string) func(presenters.Table) {
+	return func(table presenters.Table) {
+		if org, _ := state.ResolveTargetOrg(); org.Name != "" {
+			msg.InfoMsg("Organization: %s (ID: %s)", style.Emphasis(org.Name), style.Emphasis(org.Id))
+		} else {
+			msg.InfoMsg("Organization ID: %s", style.Emphasis(org.Id))
+		}
+		if proj, _ := state.ResolveTargetProject(); proj.Id == projectID && proj.Name != "" {
+			msg.InfoMsg("Project: %s (ID: %s)", style.Emphasis(proj.Name), style.Emphasis(projectID))
+		} else {
+			msg.InfoMsg("Project ID: %s", style.Emphasis(projectID))
+		}
+		msg.Blank()
+		fmt.Fprintln(os.Stderr, style.Heading("API Keys"))
+		msg.Blank()
+
+		presenters.PrintTable(table)
+	}
 }
@@ -151,7 +151,7 @@ func Run(ctx context.Context, opts configureCmdOptions) {
 		// List projects, and allow the user to pick one, or match the project-id if provided through the command
 		projects, err := ac.Project.List(ctx)
 		if err != nil {
-			msg.FailJSON(opts.json, "Error listing projects for service account")
+			msg.FailJSONError(opts.json, err, "Error listing projects for service account")
 			exit.Error(err, "Error listing projects for service account")
 		}
 
@@ -226,7 +226,7 @@ func Run(ctx context.Context, opts configureCmdOptions) {
 	// Output JSON if the option was passed
 	if opts.json {
 		targetContext := state.GetTargetContext()
-		defaultAPIKey := secrets.DefaultAPIKey.Get()
+		defaultAPIKey := secrets.GetDefaultAPIKey()
 		targetContext.DefaultAPIKey = presenters.MaskHeadTail(defaultAPIKey, 4, 4)
 		json := text.IndentJSON(targetContext)
 		fmt.Fprintln(os.Stdout, json)
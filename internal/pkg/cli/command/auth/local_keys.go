@@ -30,6 +30,7 @@ func NewLocalKeysCmd() *cobra.Command {
 	cmd.AddGroup(help.GROUP_AUTH)
 	cmd.AddCommand(NewListLocalKeysCmd())
 	cmd.AddCommand(NewPruneLocalKeysCmd())
+	cmd.AddCommand(NewRotateLocalKeysCmd())
 
 	return cmd
 }
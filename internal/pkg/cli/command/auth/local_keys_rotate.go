@@ -0,0 +1,269 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/pinecone-io/cli/internal/pkg/utils/confirm"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+type rotateLocalKeysCmdOptions struct {
+	origin           string // "cli", "user", "all"
+	projectID        string // optional filter, if not provided keys for all projects are rotated
+	olderThan        string // optional minimum age, e.g. "30d"
+	dryRun           bool   // preview keys that will be rotated
+	skipConfirmation bool   // skip confirmation prompt
+	json             bool
+}
+
+var (
+	rotateHelp = help.Long(`
+		Replace project API keys that the CLI is managing in local state.
+
+		For each key, a replacement with the same roles is created and stored locally,
+		checked with a lightweight control plane call, and the old key is then deleted
+		from Pinecone. If the replacement can't be verified, the old key is kept and the
+		replacement is deleted. Any integrations outside of the CLI that authenticate
+		with the old keys will stop working once they are deleted.
+
+		By default, only keys the CLI created are rotated. Use --origin to include keys
+		you created and stored with 'pc api-key create --store'. Use --older-than to
+		rotate only keys stored longer ago than a given age, such as '30d' or '12h'.
+		Keys stored by earlier versions of the CLI have no recorded age and are always
+		included.
+
+		The keys to rotate are listed before a confirmation prompt, as JSON on stderr
+		with --json. Use --skip-confirmation to rotate without prompting.
+
+		See: https://docs.pinecone.io/reference/cli/authentication
+	`)
+
+	rotateExample = help.Examples(`
+		# Show a dry run plan of rotating CLI-created keys older than 30 days
+		pc auth local-keys rotate --older-than 30d --dry-run
+
+		# Rotate them without prompting
+		pc auth local-keys rotate --older-than 30d --skip-confirmation
+
+		# Rotate the managed key for a single project
+		pc auth local-keys rotate --id "project-id"
+	`)
+)
+
+func NewRotateLocalKeysCmd() *cobra.Command {
+	options := rotateLocalKeysCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "rotate",
+		Short:   "Replace project API keys that the CLI is managing in local state",
+		Long:    rotateHelp,
+		Example: rotateExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			runRotateLocalKeys(cmd.Context(), options)
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.origin, "origin", "o", "cli", "Filter rotations by key origin: 'cli', 'user', 'all'")
+	cmd.Flags().StringVar(&options.projectID, "id", "", "Only rotate the key for a specific project")
+	cmd.Flags().StringVar(&options.olderThan, "older-than", "", "Only rotate keys stored longer ago than this age, e.g. '30d' or '12h'")
+	cmd.Flags().BoolVar(&options.dryRun, "dry-run", false, "Preview keys that will be rotated without applying changes")
+	cmd.Flags().BoolVar(&options.skipConfirmation, "skip-confirmation", false, "Skip confirmation prompt")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// rotatePlanItem describes a key that will be, or was, rotated.
+type rotatePlanItem struct {
+	ProjectId   string     `json:"project_id"`
+	ProjectName string     `json:"project_name,omitempty"`
+	OldKeyName  string     `json:"old_key_name"`
+	OldKeyId    string     `json:"old_key_id"`
+	Origin      string     `json:"origin"`
+	StoredAt    *time.Time `json:"stored_at,omitempty"`
+	NewKeyName  string     `json:"new_key_name,omitempty"`
+	NewKeyId    string     `json:"new_key_id,omitempty"`
+	Error       string     `json:"error,omitempty"`
+}
+
+func runRotateLocalKeys(ctx context.Context, options rotateLocalKeysCmdOptions) {
+	var olderThan time.Duration
+	if options.olderThan != "" {
+		age, err := parseAge(options.olderThan)
+		if err != nil {
+			err = clierr.Wrap(clierr.CodeUsage, err)
+			msg.FailJSON(options.json, "Invalid --older-than value: %s", err)
+			exit.Error(err, "Invalid --older-than value")
+		}
+		olderThan = age
+	}
+
+	managedKeys := secrets.GetManagedProjectKeys()
+	if options.projectID != "" {
+		if _, ok := managedKeys[options.projectID]; !ok {
+			msg.FailJSON(options.json, "No managed keys found for project ID %s", style.Emphasis(options.projectID))
+			exit.ErrorMsgf("no managed keys found for project ID: %s", options.projectID)
+		}
+	}
+
+	toRotate := selectKeysToRotate(managedKeys, options.projectID, options.origin, olderThan, time.Now())
+	if len(toRotate) == 0 {
+		if options.json {
+			fmt.Fprintln(os.Stdout, text.IndentJSON([]rotatePlanItem{}))
+			return
+		}
+		msg.InfoMsg("No locally managed API keys to rotate")
+		return
+	}
+
+	plan := make([]rotatePlanItem, 0, len(toRotate))
+	for _, key := range toRotate {
+		plan = append(plan, newRotatePlanItem(key))
+	}
+
+	if options.dryRun {
+		printRotatePlan(plan, options)
+		if !options.json {
+			msg.InfoMsg("Dry run complete. Re-run without %s to apply changes (add %s to skip the prompt)", style.Emphasis("--dry-run"), style.Emphasis("--skip-confirmation"))
+		}
+		return
+	}
+
+	if !options.skipConfirmation {
+		if options.json {
+			// Keep stdout for the result; the plan goes with the prompt.
+			fmt.Fprintln(os.Stderr, text.IndentJSON(plan))
+		} else {
+			printRotatePlan(plan, options)
+		}
+		confirm.Deletion(
+			"The old keys will be deleted once their replacements are verified.",
+			"Any integrations you have that authenticate with the old keys will stop working.",
+		)
+	}
+
+	ac := sdk.NewPineconeAdminClient(ctx)
+	failed := 0
+	for i, key := range toRotate {
+		replacement, err := sdk.RotateManagedKey(ctx, ac, key)
+		if replacement.Id != "" {
+			plan[i].NewKeyName = replacement.Name
+			plan[i].NewKeyId = replacement.Id
+			if !options.json {
+				msg.SuccessMsg("Rotated key for project %s: %s replaced by %s", style.Emphasis(key.ProjectId), style.Emphasis(key.Id), style.Emphasis(replacement.Id))
+			}
+		}
+		if err != nil {
+			failed++
+			plan[i].Error = err.Error()
+			if !options.json {
+				msg.FailMsg("Failed to rotate key %s for project %s: %s", style.Emphasis(key.Id), style.Emphasis(key.ProjectId), err)
+			}
+		}
+	}
+
+	if options.json {
+		fmt.Fprintln(os.Stdout, text.IndentJSON(plan))
+	}
+	if failed > 0 {
+		err := clierr.New(clierr.CodePartialFailure, "failed to rotate %d of %d keys", failed, len(plan))
+		if failed == len(plan) {
+			err.Code = clierr.CodeUnknown
+		}
+		exit.Error(err, "Key rotation failed")
+	}
+	if !options.json {
+		msg.SuccessMsg("Rotation complete")
+	}
+}
+
+// selectKeysToRotate returns the managed keys matching the filters, ordered by
+// project ID. Keys with no recorded creation time are always considered old
+// enough.
+func selectKeysToRotate(keys map[string]secrets.ManagedKey, projectID, origin string, olderThan time.Duration, now time.Time) []secrets.ManagedKey {
+	var selected []secrets.ManagedKey
+	for id, key := range keys {
+		if projectID != "" && id != projectID {
+			continue
+		}
+		if !includeByOrigin(key.Origin, origin) {
+			continue
+		}
+		if olderThan > 0 && !key.CreatedAt.IsZero() && now.Sub(key.CreatedAt) < olderThan {
+			continue
+		}
+		if key.ProjectId == "" {
+			key.ProjectId = id
+		}
+		selected = append(selected, key)
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].ProjectId < selected[j].ProjectId
+	})
+	return selected
+}
+
+// parseAge parses a duration, additionally accepting a whole number of days
+// with a "d" suffix (e.g. "30d").
+func parseAge(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q; use a number of days like '30d' or a duration like '12h'", value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q; use a number of days like '30d' or a duration like '12h'", value)
+	}
+	return age, nil
+}
+
+func newRotatePlanItem(key secrets.ManagedKey) rotatePlanItem {
+	item := rotatePlanItem{
+		ProjectId:   key.ProjectId,
+		ProjectName: key.ProjectName,
+		OldKeyName:  key.Name,
+		OldKeyId:    key.Id,
+		Origin:      string(key.Origin),
+	}
+	if !key.CreatedAt.IsZero() {
+		storedAt := key.CreatedAt
+		item.StoredAt = &storedAt
+	}
+	return item
+}
+
+func printRotatePlan(plan []rotatePlanItem, options rotateLocalKeysCmdOptions) {
+	if options.json {
+		fmt.Fprintln(os.Stdout, text.IndentJSON(plan))
+		return
+	}
+	for _, item := range plan {
+		storedAt := "an unknown time"
+		if item.StoredAt != nil {
+			storedAt = item.StoredAt.Format(time.RFC3339)
+		}
+		msg.WarnMsg("API key %s (ID: %s) for project %s (ID: %s), stored %s, will be replaced",
+			style.Emphasis(item.OldKeyName),
+			style.Emphasis(item.OldKeyId),
+			style.Emphasis(item.ProjectName),
+			style.Emphasis(item.ProjectId),
+			storedAt)
+	}
+}
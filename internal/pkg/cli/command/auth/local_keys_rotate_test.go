@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "30d", want: 30 * 24 * time.Hour},
+		{value: "0d", want: 0},
+		{value: "12h", want: 12 * time.Hour},
+		{value: "90m", want: 90 * time.Minute},
+		{value: "d", wantErr: true},
+		{value: "-1d", wantErr: true},
+		{value: "1.5d", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseAge(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSelectKeysToRotate(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	keys := map[string]secrets.ManagedKey{
+		"proj-old":    {Id: "k1", ProjectId: "proj-old", Origin: secrets.OriginCLICreated, CreatedAt: now.Add(-45 * 24 * time.Hour)},
+		"proj-new":    {Id: "k2", ProjectId: "proj-new", Origin: secrets.OriginCLICreated, CreatedAt: now.Add(-2 * 24 * time.Hour)},
+		"proj-legacy": {Id: "k3", ProjectId: "proj-legacy", Origin: secrets.OriginCLICreated},
+		"proj-user":   {Id: "k4", ProjectId: "proj-user", Origin: secrets.OriginUserCreated, CreatedAt: now.Add(-60 * 24 * time.Hour)},
+	}
+
+	ids := func(selected []secrets.ManagedKey) []string {
+		var out []string
+		for _, k := range selected {
+			out = append(out, k.Id)
+		}
+		return out
+	}
+
+	t.Run("older than excludes recent keys but keeps keys of unknown age", func(t *testing.T) {
+		got := selectKeysToRotate(keys, "", "cli", 30*24*time.Hour, now)
+		assert.Equal(t, []string{"k3", "k1"}, ids(got))
+	})
+
+	t.Run("no age filter selects every cli key", func(t *testing.T) {
+		got := selectKeysToRotate(keys, "", "cli", 0, now)
+		assert.Equal(t, []string{"k3", "k2", "k1"}, ids(got))
+	})
+
+	t.Run("origin all includes user keys", func(t *testing.T) {
+		got := selectKeysToRotate(keys, "", "all", 30*24*time.Hour, now)
+		assert.Equal(t, []string{"k3", "k1", "k4"}, ids(got))
+	})
+
+	t.Run("project filter", func(t *testing.T) {
+		got := selectKeysToRotate(keys, "proj-new", "all", 0, now)
+		assert.Equal(t, []string{"k2"}, ids(got))
+	})
+}
@@ -44,11 +44,21 @@ var (
 		automatically. After authentication is complete, the first subsequent
 		command also sets the target context automatically, so a separate
 		'pc target' call is not required.
+
+		DEVICE MODE (--device)
+
+		For headless machines, such as a remote dev box over SSH or a container,
+		where the browser can't reach the CLI's local callback. Prints a
+		verification URL and a short code; open the URL on any device, enter the
+		code, and the CLI finishes logging in once you approve. With --json, a
+		{"status":"pending",...} document with "verification_uri" and
+		"user_code" is printed first, followed by the authenticated document.
 	`)
 )
 
 func NewLoginCmd() *cobra.Command {
 	var jsonOutput bool
+	var device bool
 
 	cmd := &cobra.Command{
 		Use:   "login",
@@ -63,14 +73,18 @@ func NewLoginCmd() *cobra.Command {
 
 			# Agentic login — second call (or any command) completes the flow
 			pc auth login --json
+
+			# Log in from a headless machine with a device code
+			pc auth login --device
 		`),
 		GroupID: help.GROUP_AUTH.ID,
 		Run: func(cmd *cobra.Command, args []string) {
-			login.Run(cmd.Context(), login.Options{Json: jsonOutput})
+			login.Run(cmd.Context(), login.Options{Json: jsonOutput, Device: device})
 		},
 	}
 
 	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "emit JSON output")
+	cmd.Flags().BoolVar(&device, "device", false, "log in with a device code instead of a local browser callback, for headless machines")
 
 	return cmd
 }
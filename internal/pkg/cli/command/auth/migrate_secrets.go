@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secretstore"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+type migrateSecretsCmdOptions struct {
+	to         string
+	keepSource bool
+	json       bool
+}
+
+var (
+	migrateSecretsHelp = help.Long(`
+		Move stored secrets to a different storage backend.
+
+		Secrets include OAuth tokens, service account credentials, the default API key,
+		and the API keys the CLI manages for each project. By default they are stored in
+		plaintext in secrets.yaml in the config directory. Supported backends are:
+
+		- file: plaintext secrets.yaml (default)
+		- keyring: the platform keyring (macOS Keychain, Secret Service API on Linux,
+		  Windows Credential Manager)
+		- encrypted-file: secrets.yaml.age, encrypted with age using the passphrase in
+		  PINECONE_SECRETS_PASSPHRASE (prompted for on a terminal), or the age identity
+		  configured with 'pc config set secrets-age-identity <path>'
+
+		This command copies every secret to the new backend, verifies it, selects the
+		new backend in config.yaml, and then removes the secrets from the old backend
+		unless --keep-source is passed.
+	`)
+
+	migrateSecretsExample = help.Examples(`
+		# Move secrets into the OS keyring
+		pc auth migrate-secrets --to keyring
+
+		# Move secrets into a passphrase-protected encrypted file on a headless machine
+		PINECONE_SECRETS_PASSPHRASE="..." pc auth migrate-secrets --to encrypted-file
+
+		# Move secrets back to the plaintext file, keeping the keyring entries
+		pc auth migrate-secrets --to file --keep-source
+	`)
+)
+
+func NewMigrateSecretsCmd() *cobra.Command {
+	options := migrateSecretsCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "migrate-secrets",
+		Short:   "Move stored secrets to a different storage backend",
+		Long:    migrateSecretsHelp,
+		Example: migrateSecretsExample,
+		GroupID: help.GROUP_AUTH.ID,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runMigrateSecrets(options); err != nil {
+				msg.FailJSON(options.json, "Failed to migrate secrets: %s", err)
+				exit.Error(err, "Failed to migrate secrets")
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.to, "to", "", "Backend to move secrets to: 'file', 'keyring', or 'encrypted-file'")
+	cmd.Flags().BoolVar(&options.keepSource, "keep-source", false, "Keep the secrets in the current backend after copying them")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+func runMigrateSecrets(options migrateSecretsCmdOptions) error {
+	type migrateOutput struct {
+		From          string `json:"from"`
+		To            string `json:"to"`
+		Migrated      int    `json:"migrated"`
+		SourceCleared bool   `json:"source_cleared"`
+	}
+
+	if err := secretstore.ValidateBackendName(options.to); err != nil {
+		return err
+	}
+
+	from := secrets.BackendName()
+	if from == options.to {
+		if options.json {
+			fmt.Fprintln(os.Stdout, text.IndentJSON(migrateOutput{From: from, To: options.to}))
+			return nil
+		}
+		msg.InfoMsg("Secrets are already stored in the %s backend", style.Emphasis(from))
+		return nil
+	}
+
+	src, err := secrets.OpenBackend(from)
+	if err != nil {
+		return err
+	}
+	dst, err := secrets.OpenBackend(options.to)
+	if err != nil {
+		return err
+	}
+
+	count, err := secrets.Migrate(src, dst)
+	if err != nil {
+		return err
+	}
+	config.SecretsBackend.Set(options.to)
+
+	if !options.keepSource {
+		if err := src.Clear(); err != nil {
+			return fmt.Errorf("secrets were copied to %s, but could not be removed from %s: %w", options.to, from, err)
+		}
+	}
+
+	if options.json {
+		fmt.Fprintln(os.Stdout, text.IndentJSON(migrateOutput{From: from, To: options.to, Migrated: count, SourceCleared: !options.keepSource}))
+		return nil
+	}
+
+	msg.SuccessMsg("Moved %d secrets from %s to %s", count, style.Emphasis(from), style.Emphasis(options.to))
+	if !options.keepSource {
+		msg.InfoMsg("Secrets were removed from the %s backend", style.Emphasis(from))
+	}
+	if os.Getenv("PINECONE_SECRETS_BACKEND") != "" {
+		msg.WarnMsg("PINECONE_SECRETS_BACKEND is set and overrides the stored backend; update or unset it to use %s", style.Emphasis(options.to))
+	}
+	return nil
+}
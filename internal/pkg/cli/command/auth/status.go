@@ -50,16 +50,17 @@ func runAuthStatus(cmd *cobra.Command, options authStatusCmdOptions) error {
 	}
 
 	authMode := string(state.AuthedUser.Get().AuthContext)
-	orgName := state.TargetOrg.Get().Name
-	projName := state.TargetProj.Get().Name
-	environment := config.Environment.Get()
+	targetContext := state.GetTargetContext()
+	orgName := targetContext.Organization.Name
+	projName := targetContext.Project.Name
+	environment := config.GetEnvironment()
 
 	// Default API Key
-	defaultAPIKey := secrets.DefaultAPIKey.Get()
+	defaultAPIKey := secrets.GetDefaultAPIKey()
 
 	// Service Account
-	clientId := secrets.ClientId.Get()
-	clientSecret := secrets.ClientSecret.Get()
+	clientId := secrets.GetClientId()
+	clientSecret := secrets.GetClientSecret()
 
 	// Extract token information
 	var claims *oauth.MyCustomClaims
@@ -91,6 +92,7 @@ func runAuthStatus(cmd *cobra.Command, options authStatusCmdOptions) error {
 		DefaultAPIKey:       presenters.MaskHeadTail(defaultAPIKey, 4, 4),
 		ClientID:            clientId,
 		ClientSecret:        presenters.MaskHeadTail(clientSecret, 4, 4),
+		CredentialHelper:    config.CredentialHelper.Get(),
 		TokenExpiry:         expStr,
 		TokenTimeRemaining:  remaining,
 		TokenScope:          scope,
@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+type clearCmdOptions struct {
+	json bool
+}
+
+func NewClearCmd() *cobra.Command {
+	options := clearCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Delete every cached index, project and completion value",
+		Example: help.Examples(`
+		    pc cache clear
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := cache.Clear(); err != nil {
+				msg.FailJSON(options.json, "Failed to clear the cache: %s", err)
+				exit.Error(err, "Failed to clear the cache")
+			}
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(struct {
+					Cleared bool   `json:"cleared"`
+					Path    string `json:"path"`
+				}{Cleared: true, Path: cache.Dir()}))
+				return
+			}
+			msg.SuccessMsg("Cache cleared")
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
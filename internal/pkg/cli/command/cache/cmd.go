@@ -0,0 +1,34 @@
+package cache
+
+import (
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cacheHelp = help.LongF(`
+		Manage the CLI's local cache.
+
+		To save round trips, the CLI caches index hosts and descriptions, project
+		details and shell completion values in the %s directory for a few
+		minutes. Commands that create, configure or delete an index or project
+		update the cache themselves, but changes made elsewhere (in the console
+		or another machine) may take a few minutes to be seen.
+
+		Pass --no-cache to any command to skip cached values, or clear the cache
+		with 'pc cache clear'.
+	`, cache.Dir())
+)
+
+func NewCacheCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the CLI's local metadata cache",
+		Long:  cacheHelp,
+	}
+
+	cmd.AddCommand(NewClearCmd())
+
+	return cmd
+}
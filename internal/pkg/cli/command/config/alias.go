@@ -0,0 +1,182 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	conf "github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+// NewAliasCmd returns the top-level pc alias command. Aliases are stored as
+// alias.<name> keys of the config registry, so pc config get, set and unset
+// work on them too.
+func NewAliasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Create shortcuts for commands you run often",
+		Long: help.Long(`
+			Create shortcuts for long commands. Running 'pc <alias> [args]' runs pc
+			with the alias's expansion followed by args.
+
+			Placeholders in the expansion are replaced with the alias's arguments:
+			$1, $2, ... with the matching argument and $@ with all of them.
+			Arguments that no placeholder uses are appended to the end. Quote the
+			expansion so that your shell doesn't replace the placeholders itself.
+
+			Aliases are stored in config.yaml and listed under "Aliases" in
+			'pc --help'. They can't shadow built-in commands.
+		`),
+		Example: help.Examples(`
+		    pc alias set q 'index record search --index-name prod-docs --namespace en --top-k 5 --inputs'
+		    pc q '{"text": "how do I rotate keys?"}'
+
+		    pc alias set stats-of 'index stats --index-name $1 --json'
+		    pc stats-of prod-docs
+		`),
+	}
+
+	cmd.AddCommand(newAliasSetCmd())
+	cmd.AddCommand(newAliasListCmd())
+	cmd.AddCommand(newAliasDeleteCmd())
+
+	return cmd
+}
+
+type aliasCmdOptions struct {
+	json bool
+}
+
+type aliasEntry struct {
+	Name      string `json:"name"`
+	Expansion string `json:"expansion"`
+}
+
+func newAliasSetCmd() *cobra.Command {
+	options := aliasCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <name> <expansion>",
+		Short: "Create or update an alias",
+		Example: help.Examples(`
+		    pc alias set q 'index record search --index-name prod-docs --namespace en --top-k 5 --inputs'
+		    pc alias set fetch-ids 'index vector fetch --index-name $1 --ids $@'
+		`),
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			name, expansion := args[0], args[1]
+			svc := newDefaultConfigService()
+			if _, err := svc.Set(cmd.Context(), aliasKeyPrefix+name, expansion); err != nil && !errors.Is(err, ErrNoChange) {
+				msg.FailJSON(options.json, "Failed to set alias: %s", err)
+				exit.Error(err, "Failed to set alias")
+			}
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(aliasEntry{Name: name, Expansion: conf.Aliases.Get()[name]}))
+				return
+			}
+			msg.SuccessMsg("Alias %s saved: %s", style.Emphasis(name), style.Code("pc "+conf.Aliases.Get()[name]))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+func newAliasListCmd() *cobra.Command {
+	options := aliasCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List aliases",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			entries := listAliases(conf.Aliases.Get())
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(entries))
+				return
+			}
+			if len(entries) == 0 {
+				msg.InfoMsg("No aliases set. Create one with %s", style.Code("pc alias set <name> <expansion>"))
+				return
+			}
+
+			w := presenters.NewTabWriter()
+			fmt.Fprintln(w, "NAME\tEXPANSION")
+			for _, e := range entries {
+				fmt.Fprintf(w, "%s\t%s\n", e.Name, e.Expansion)
+			}
+			w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+func newAliasDeleteCmd() *cobra.Command {
+	options := aliasCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete an alias",
+		Args:  cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			names := make([]string, 0)
+			for _, e := range listAliases(conf.Aliases.Get()) {
+				names = append(names, e.Name)
+			}
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			if _, ok := conf.Aliases.Get()[name]; !ok {
+				err := fmt.Errorf("alias %q not found", name)
+				msg.FailJSON(options.json, "%s", err)
+				exit.Error(err, "Alias not found")
+			}
+			svc := newDefaultConfigService()
+			if _, err := svc.Unset(cmd.Context(), aliasKeyPrefix+name); err != nil && !errors.Is(err, ErrNoChange) {
+				msg.FailJSON(options.json, "Failed to delete alias: %s", err)
+				exit.Error(err, "Failed to delete alias")
+			}
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(struct {
+					Name    string `json:"name"`
+					Deleted bool   `json:"deleted"`
+				}{Name: name, Deleted: true}))
+				return
+			}
+			msg.SuccessMsg("Alias %s deleted", style.Emphasis(name))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// listAliases returns the aliases sorted by name.
+func listAliases(aliases map[string]string) []aliasEntry {
+	entries := make([]aliasEntry, 0, len(aliases))
+	for _, name := range slices.Sorted(maps.Keys(aliases)) {
+		entries = append(entries, aliasEntry{Name: name, Expansion: aliases[name]})
+	}
+	return entries
+}
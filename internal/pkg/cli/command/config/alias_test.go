@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_listAliases_SortedByName(t *testing.T) {
+	entries := listAliases(map[string]string{
+		"stats": "index stats --index-name $1",
+		"q":     "index record search --inputs",
+	})
+	assert.Equal(t, []aliasEntry{
+		{Name: "q", Expansion: "index record search --inputs"},
+		{Name: "stats", Expansion: "index stats --index-name $1"},
+	}, entries)
+}
+
+func Test_listAliases_Empty(t *testing.T) {
+	assert.Equal(t, []aliasEntry{}, listAliases(nil))
+}
@@ -27,6 +27,7 @@ func NewConfigCmd() *cobra.Command {
 	cmd.AddCommand(NewUnsetCmd())
 	cmd.AddCommand(NewListCmd())
 	cmd.AddCommand(NewDescribeCmd())
+	cmd.AddCommand(NewEnvironmentCmd())
 
 	// Deprecated aliases kept for backwards compatibility
 	cmd.AddCommand(NewGetApiKeyCmd())
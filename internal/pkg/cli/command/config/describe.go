@@ -53,6 +53,7 @@ func runDescribeCmd(svc ConfigService, keyName string, opts DescribeCmdOptions)
 		Value          string   `json:"value"`
 		EnvVarName     string   `json:"env_var_name,omitempty"`
 		EnvVarOverride *bool    `json:"env_var_override,omitempty"`
+		Source         string   `json:"source"`
 		Description    string   `json:"description"`
 		Sensitive      bool     `json:"sensitive"`
 		ValidValues    []string `json:"valid_values,omitempty"`
@@ -72,6 +73,7 @@ func runDescribeCmd(svc ConfigService, keyName string, opts DescribeCmdOptions)
 			Key:         desc.Key,
 			Value:       value,
 			EnvVarName:  desc.EnvVarName,
+			Source:      string(desc.Source),
 			Description: desc.Description,
 			Sensitive:   desc.Sensitive,
 			ValidValues: desc.ValidValues,
@@ -86,6 +88,7 @@ func runDescribeCmd(svc ConfigService, keyName string, opts DescribeCmdOptions)
 	w := presenters.NewTabWriter()
 	fmt.Fprintf(w, "KEY\t%s\n", desc.Key)
 	fmt.Fprintf(w, "VALUE\t%s\n", displayValue(value))
+	fmt.Fprintf(w, "SOURCE\t%s\n", desc.Source)
 	if desc.EnvVarName != "" {
 		fmt.Fprintf(w, "ENV VAR NAME\t$%s\n", desc.EnvVarName)
 		fmt.Fprintf(w, "ENV VAR OVERRIDE\t%s\n", text.BoolToString(desc.EnvVarOverride))
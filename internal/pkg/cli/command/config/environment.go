@@ -0,0 +1,304 @@
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	conf "github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/environment"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+func NewEnvironmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "environment",
+		Short: "Manage custom Pinecone environments",
+		Long: help.Long(`
+			Manage user-defined environments, such as a local emulator or a private
+			endpoint, alongside the built-in production and staging environments.
+
+			A custom environment needs at least an API URL. Environments without
+			an Auth0 URL and client ID don't support 'pc login'; authenticate with
+			an API key instead. An index host override routes every index
+			connection to a fixed host, with "{index}" replaced by the index name.
+
+			Once added, select an environment with 'pc config set environment <name>'.
+		`),
+		Example: help.Examples(`
+		    pc config environment add local --api-url http://localhost:5080 --index-host "localhost:5081"
+		    pc config environment list
+		    pc config environment remove local
+		`),
+	}
+
+	cmd.AddCommand(newEnvironmentAddCmd())
+	cmd.AddCommand(newEnvironmentListCmd())
+	cmd.AddCommand(newEnvironmentRemoveCmd())
+
+	return cmd
+}
+
+type environmentAddCmdOptions struct {
+	env  conf.CustomEnvironment
+	json bool
+}
+
+func newEnvironmentAddCmd() *cobra.Command {
+	options := environmentAddCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <name>",
+		Short: "Add or update a custom environment",
+		Example: help.Examples(`
+		    pc config environment add local --api-url http://localhost:5080
+		    pc config environment add private --api-url https://api.example.com \
+		        --auth-url https://login.example.com --auth-client-id abc123 \
+		        --auth-audience https://api.example.com/v1
+		`),
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			var saved conf.CustomEnvironment
+			err := conf.Environments.TryUpdate(func(envs *map[string]conf.CustomEnvironment) error {
+				if *envs == nil {
+					*envs = map[string]conf.CustomEnvironment{}
+				}
+				if err := addCustomEnvironment(*envs, name, options.env); err != nil {
+					return err
+				}
+				saved = (*envs)[name]
+				return nil
+			})
+			if err != nil {
+				msg.FailJSON(options.json, "Failed to add environment: %s", err)
+				exit.Error(err, "Failed to add environment")
+			}
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(environmentListEntry{Name: name, CustomEnvironment: saved}))
+				return
+			}
+			msg.SuccessMsg("Environment %s saved", style.Emphasis(name))
+			msg.HintMsg("To use it, run %s", style.Code("pc config set environment "+name))
+		},
+	}
+
+	cmd.Flags().StringVar(&options.env.APIURL, "api-url", "", "Base URL of the control plane API")
+	cmd.Flags().StringVar(&options.env.DashboardURL, "dashboard-url", "", "Base URL of the dashboard API")
+	cmd.Flags().StringVar(&options.env.Auth0URL, "auth-url", "", "Auth0 URL used by pc login")
+	cmd.Flags().StringVar(&options.env.Auth0ClientId, "auth-client-id", "", "Auth0 client ID used by pc login")
+	cmd.Flags().StringVar(&options.env.Auth0Audience, "auth-audience", "", "Auth0 audience used by pc login")
+	cmd.Flags().StringVar(&options.env.IndexHost, "index-host", "", "Host used for every index, \"{index}\" is replaced with the index name")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	_ = cmd.MarkFlagRequired("api-url")
+
+	return cmd
+}
+
+type environmentListCmdOptions struct {
+	json bool
+}
+
+type environmentListEntry struct {
+	Name    string `json:"name"`
+	Builtin bool   `json:"builtin"`
+	Current bool   `json:"current"`
+	conf.CustomEnvironment
+}
+
+func newEnvironmentListCmd() *cobra.Command {
+	options := environmentListCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List built-in and custom environments",
+		Example: help.Examples(`
+		    pc config environment list
+		    pc config environment list --json
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			entries := listEnvironments(conf.Environments.Get(), conf.GetEnvironment())
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(entries))
+				return
+			}
+
+			w := presenters.NewTabWriter()
+			fmt.Fprintln(w, "NAME\tCURRENT\tTYPE\tAPI URL\tINDEX HOST\tLOGIN")
+			for _, e := range entries {
+				current, kind := "", "custom"
+				if e.Current {
+					current = "*"
+				}
+				if e.Builtin {
+					kind = "built-in"
+				}
+				login := e.Auth0URL != "" && e.Auth0ClientId != ""
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+					e.Name, current, kind,
+					displayValue(e.APIURL),
+					displayValue(e.IndexHost),
+					text.BoolToString(login))
+			}
+			w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+type environmentRemoveCmdOptions struct {
+	json bool
+}
+
+func newEnvironmentRemoveCmd() *cobra.Command {
+	options := environmentRemoveCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <name>",
+		Short: "Remove a custom environment",
+		Example: help.Examples(`
+		    pc config environment remove local
+		`),
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			var names []string
+			for name := range conf.Environments.Get() {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			return names, cobra.ShellCompDirectiveNoFileComp
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			err := conf.Environments.TryUpdate(func(envs *map[string]conf.CustomEnvironment) error {
+				return removeCustomEnvironment(*envs, name, conf.GetEnvironment())
+			})
+			if err != nil {
+				msg.FailJSON(options.json, "Failed to remove environment: %s", err)
+				exit.Error(err, "Failed to remove environment")
+			}
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(struct {
+					Name    string `json:"name"`
+					Removed bool   `json:"removed"`
+				}{Name: name, Removed: true}))
+				return
+			}
+			msg.SuccessMsg("Environment %s removed", style.Emphasis(name))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// addCustomEnvironment validates env and stores it in envs under name,
+// replacing any previous definition.
+func addCustomEnvironment(envs map[string]conf.CustomEnvironment, name string, env conf.CustomEnvironment) error {
+	if name == "" || strings.ContainsAny(name, " \t/") {
+		return fmt.Errorf("invalid environment name %q", name)
+	}
+	if slices.Contains(conf.BuiltinEnvironments, name) || name == "prod" {
+		return fmt.Errorf("%q is a built-in environment and can't be redefined", name)
+	}
+	urls := []struct{ flag, value string }{
+		{"--api-url", env.APIURL},
+		{"--dashboard-url", env.DashboardURL},
+		{"--auth-url", env.Auth0URL},
+	}
+	for _, u := range urls {
+		if u.value == "" {
+			continue
+		}
+		if err := validateBaseURL(u.value); err != nil {
+			return fmt.Errorf("%s: %w", u.flag, err)
+		}
+	}
+	if env.APIURL == "" {
+		return fmt.Errorf("--api-url is required")
+	}
+	if (env.Auth0URL == "") != (env.Auth0ClientId == "") {
+		return fmt.Errorf("--auth-url and --auth-client-id must be set together")
+	}
+	env.APIURL = strings.TrimRight(env.APIURL, "/")
+	env.DashboardURL = strings.TrimRight(env.DashboardURL, "/")
+	env.Auth0URL = strings.TrimRight(env.Auth0URL, "/")
+	envs[name] = env
+	return nil
+}
+
+// removeCustomEnvironment deletes name from envs. The environment currently in
+// use can't be removed, since the CLI would no longer be able to resolve it.
+func removeCustomEnvironment(envs map[string]conf.CustomEnvironment, name, current string) error {
+	if slices.Contains(conf.BuiltinEnvironments, name) {
+		return fmt.Errorf("%q is a built-in environment and can't be removed", name)
+	}
+	if _, ok := envs[name]; !ok {
+		return fmt.Errorf("environment %q not found", name)
+	}
+	if name == current {
+		return fmt.Errorf("environment %q is in use; switch with %s first", name, style.Code("pc config set environment production"))
+	}
+	delete(envs, name)
+	return nil
+}
+
+// listEnvironments returns the built-in environments followed by the custom
+// ones sorted by name.
+func listEnvironments(custom map[string]conf.CustomEnvironment, current string) []environmentListEntry {
+	entries := []environmentListEntry{}
+	for _, name := range conf.BuiltinEnvironments {
+		settings, _ := environment.GetEnvConfig(name)
+		entries = append(entries, environmentListEntry{
+			Name:    name,
+			Builtin: true,
+			Current: name == current,
+			CustomEnvironment: conf.CustomEnvironment{
+				APIURL:        settings.PineconeGCPURL,
+				DashboardURL:  settings.DashboardUrl,
+				Auth0URL:      settings.Auth0URL,
+				Auth0ClientId: settings.Auth0ClientId,
+				Auth0Audience: settings.Auth0Audience,
+			},
+		})
+	}
+	names := make([]string, 0, len(custom))
+	for name := range custom {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		entries = append(entries, environmentListEntry{Name: name, Current: name == current, CustomEnvironment: custom[name]})
+	}
+	return entries
+}
+
+func validateBaseURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%q must be an absolute http or https URL", raw)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"testing"
+
+	conf "github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_addCustomEnvironment(t *testing.T) {
+	envs := map[string]conf.CustomEnvironment{}
+
+	err := addCustomEnvironment(envs, "local", conf.CustomEnvironment{
+		APIURL:    "http://localhost:5080/",
+		IndexHost: "localhost:5081",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:5080", envs["local"].APIURL)
+	assert.Equal(t, "localhost:5081", envs["local"].IndexHost)
+}
+
+func Test_addCustomEnvironment_Rejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		envName string
+		env     conf.CustomEnvironment
+	}{
+		{"builtin", "production", conf.CustomEnvironment{APIURL: "https://api.example.com"}},
+		{"prod alias", "prod", conf.CustomEnvironment{APIURL: "https://api.example.com"}},
+		{"empty name", "", conf.CustomEnvironment{APIURL: "https://api.example.com"}},
+		{"missing api url", "custom", conf.CustomEnvironment{}},
+		{"relative api url", "custom", conf.CustomEnvironment{APIURL: "api.example.com"}},
+		{"bad scheme", "custom", conf.CustomEnvironment{APIURL: "ftp://api.example.com"}},
+		{"auth url without client id", "custom", conf.CustomEnvironment{APIURL: "https://api.example.com", Auth0URL: "https://login.example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			envs := map[string]conf.CustomEnvironment{}
+			assert.Error(t, addCustomEnvironment(envs, tt.envName, tt.env))
+			assert.Empty(t, envs)
+		})
+	}
+}
+
+func Test_removeCustomEnvironment(t *testing.T) {
+	envs := map[string]conf.CustomEnvironment{
+		"local":   {APIURL: "http://localhost:5080"},
+		"private": {APIURL: "https://api.example.com"},
+	}
+
+	assert.Error(t, removeCustomEnvironment(envs, "staging", "production"))
+	assert.Error(t, removeCustomEnvironment(envs, "missing", "production"))
+	assert.Error(t, removeCustomEnvironment(envs, "local", "local"), "the environment in use can't be removed")
+
+	require.NoError(t, removeCustomEnvironment(envs, "local", "private"))
+	assert.NotContains(t, envs, "local")
+	assert.Contains(t, envs, "private")
+}
+
+func Test_listEnvironments(t *testing.T) {
+	entries := listEnvironments(map[string]conf.CustomEnvironment{
+		"zeta":  {APIURL: "https://zeta.example.com"},
+		"alpha": {APIURL: "https://alpha.example.com"},
+	}, "alpha")
+
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Name
+	}
+	assert.Equal(t, []string{"production", "staging", "alpha", "zeta"}, names)
+	assert.True(t, entries[0].Builtin)
+	assert.Equal(t, "https://api.pinecone.io", entries[0].APIURL)
+	assert.True(t, entries[2].Current)
+	assert.False(t, entries[0].Current)
+}
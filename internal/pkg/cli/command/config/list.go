@@ -49,6 +49,7 @@ func runListCmd(svc ConfigService, opts ListCmdOptions) {
 		Value          string `json:"value"`
 		EnvVarName     string `json:"env_var_name,omitempty"`
 		EnvVarOverride *bool  `json:"env_var_override,omitempty"`
+		Source         string `json:"source"`
 		Description    string `json:"description"`
 		Hidden         bool   `json:"hidden,omitempty"`
 	}
@@ -62,7 +63,7 @@ func runListCmd(svc ConfigService, opts ListCmdOptions) {
 			if e.Sensitive && !opts.reveal {
 				value = presenters.MaskHeadTail(value, 4, 4)
 			}
-			entry := listOutput{Key: e.Key, Value: value, EnvVarName: e.EnvVarName, Description: e.Description, Hidden: e.Hidden}
+			entry := listOutput{Key: e.Key, Value: value, EnvVarName: e.EnvVarName, Source: string(e.Source), Description: e.Description, Hidden: e.Hidden}
 			if e.EnvVarName != "" {
 				entry.EnvVarOverride = &e.EnvVarOverride
 			}
@@ -73,7 +74,7 @@ func runListCmd(svc ConfigService, opts ListCmdOptions) {
 	}
 
 	w := presenters.NewTabWriter()
-	fmt.Fprintln(w, "KEY\tVALUE\tENV VAR NAME\tENV VAR OVERRIDE\tDESCRIPTION")
+	fmt.Fprintln(w, "KEY\tVALUE\tSOURCE\tENV VAR NAME\tENV VAR OVERRIDE\tDESCRIPTION")
 	for _, e := range entries {
 		value := e.Value
 		if e.Sensitive && !opts.reveal {
@@ -90,8 +91,9 @@ func runListCmd(svc ConfigService, opts ListCmdOptions) {
 		}
 
 		fmt.Fprintf(w,
-			"%s\t%s\t%s\t%s\t%s\n",
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
 			e.Key, displayVal,
+			e.Source,
 			e.EnvVarName,
 			envOverride,
 			e.Description)
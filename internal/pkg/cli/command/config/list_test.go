@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/pinecone-io/cli/internal/pkg/cli/testutils"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,6 +17,7 @@ func Test_runListCmd_TabularOutputIncludesHeader(t *testing.T) {
 
 	assert.Contains(t, out, "KEY")
 	assert.Contains(t, out, "VALUE")
+	assert.Contains(t, out, "SOURCE")
 	assert.Contains(t, out, "ENV VAR NAME")
 	assert.Contains(t, out, "ENV VAR OVERRIDE")
 	assert.Contains(t, out, "DESCRIPTION")
@@ -178,3 +180,17 @@ func Test_runListCmd_JSONOutputEnvVarOverrideIsFalseWhenNotActive(t *testing.T)
 	assert.Contains(t, out, `"PINECONE_ENVIRONMENT"`)
 	assert.Contains(t, out, `"env_var_override": false`)
 }
+
+func Test_runListCmd_JSONOutputIncludesSource(t *testing.T) {
+	svc := &mockConfigService{
+		listResult: []ConfigEntry{
+			{Key: "environment", Value: "staging", Source: configuration.SourceProjectFile},
+		},
+	}
+
+	out := testutils.CaptureStdout(t, func() {
+		runListCmd(svc, ListCmdOptions{json: true, all: true})
+	})
+
+	assert.Contains(t, out, `"source": "project file"`)
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"context"
 	"testing"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -172,3 +174,53 @@ func TestDefaultConfigService_Describe_EnvVarNameEmptyForUnboundKey(t *testing.T
 	assert.Empty(t, desc.EnvVarName)
 	assert.False(t, desc.EnvVarOverride)
 }
+
+func TestEffectiveValue_PrecedenceEnvThenProjectFileThenStored(t *testing.T) {
+	desc := keyDescriptor{
+		getStr:         func() string { return "production" },
+		envVarName:     "PINECONE_TEST_EFFECTIVE_VALUE",
+		projectFileStr: func() string { return "staging" },
+	}
+
+	t.Setenv("PINECONE_TEST_EFFECTIVE_VALUE", "from-env")
+	value, source := desc.effectiveValue()
+	assert.Equal(t, "from-env", value)
+	assert.Equal(t, configuration.SourceEnv, source)
+	assert.True(t, desc.isOverridden())
+
+	t.Setenv("PINECONE_TEST_EFFECTIVE_VALUE", "")
+	value, source = desc.effectiveValue()
+	assert.Equal(t, "staging", value)
+	assert.Equal(t, configuration.SourceProjectFile, source)
+	assert.True(t, desc.isOverridden())
+
+	desc.projectFileStr = func() string { return "" }
+	value, source = desc.effectiveValue()
+	assert.Equal(t, "production", value)
+	assert.Equal(t, configuration.SourceGlobal, source)
+	assert.False(t, desc.isOverridden())
+}
+
+func TestCredentialHelperOnChange_RejectsUnknownHelper(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := configRegistry["credential-helper"].onChange(context.Background(), "", "missing")
+
+	assert.Error(t, err)
+}
+
+func TestLookupKey_Alias(t *testing.T) {
+	desc, err := lookupKey("alias.q")
+	assert.NoError(t, err)
+	assert.Contains(t, desc.Description, "pc q")
+
+	normalized, err := desc.validateStr("  index list  ")
+	assert.NoError(t, err)
+	assert.Equal(t, "index list", normalized)
+
+	_, err = desc.validateStr("index 'unterminated")
+	assert.Error(t, err)
+
+	_, err = lookupKey("alias.two words")
+	assert.Error(t, err)
+}
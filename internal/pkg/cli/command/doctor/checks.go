@@ -0,0 +1,579 @@
+package doctor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secretstore"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/environment"
+	"github.com/pinecone-io/cli/internal/pkg/utils/oauth"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// checkTimeout bounds each network check so one unreachable host doesn't
+	// stall the whole report.
+	checkTimeout = 10 * time.Second
+	// maxIndexHosts caps how many index hosts are probed.
+	maxIndexHosts = 5
+
+	clockSkewWarn = 30 * time.Second
+	clockSkewFail = 5 * time.Minute
+)
+
+// doctor runs the checks in order; later checks use what earlier ones found.
+type doctor struct {
+	report     *Report
+	settings   transport.Settings
+	httpClient *http.Client
+	// settingsErr is set when the connection settings are invalid; the SDK
+	// clients would exit on them, so checks that need one are skipped.
+	settingsErr error
+
+	envSettings environment.EnvironmentConnectionSettings
+	envOK       bool
+	token       *oauth2.Token
+	credentials sdk.CredentialSource
+	serverTime  time.Time
+	localTime   time.Time
+	projectKey  string
+}
+
+func (d *doctor) run(ctx context.Context) {
+	// Token refreshes use the same client as the probes.
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, d.httpClient)
+	d.checkConfigDir()
+	d.checkSecrets()
+	d.checkEnvironment()
+	d.checkProxy()
+	d.checkTLS()
+	d.checkCredentials(ctx)
+	d.checkToken()
+	d.checkAPIHost(ctx)
+	d.checkAuthHost(ctx)
+	d.checkClockSkew()
+	d.checkTarget(ctx)
+	d.checkManagedKeys(ctx)
+	d.checkIndexHosts(ctx)
+}
+
+func (d *doctor) checkConfigDir() {
+	const name = "Config directory"
+	dir := configuration.ConfigDirPath()
+	f, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		d.report.fail(name, fmt.Sprintf("Make %s writable by the current user, or set HOME to a writable directory", dir), "%s is not writable: %s", dir, err)
+		return
+	}
+	f.Close()
+	os.Remove(f.Name())
+	d.report.pass(name, "%s is writable", dir)
+}
+
+func (d *doctor) checkSecrets() {
+	const name = "Secrets storage"
+	backend := secrets.BackendName()
+	switch backend {
+	case secretstore.BackendKeyring:
+		d.report.pass(name, "secrets are stored in the platform keyring")
+	case secretstore.BackendEncryptedFile:
+		d.report.add(filePermissionCheck(name, secrets.EncryptedFilePath()))
+	default:
+		d.report.add(filePermissionCheck(name, secrets.ConfigFile.Path()))
+	}
+}
+
+// filePermissionCheck reports whether a secrets file is private to its owner.
+func filePermissionCheck(name, path string) Check {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Check{Name: name, Status: StatusPass, Message: fmt.Sprintf("%s does not exist yet; no secrets are stored", path)}
+	}
+	if err != nil {
+		return Check{Name: name, Status: StatusFail, Message: fmt.Sprintf("cannot read %s: %s", path, err), Remedy: fmt.Sprintf("Check the ownership and permissions of %s", path)}
+	}
+	mode := info.Mode().Perm()
+	// Windows doesn't report POSIX permissions.
+	if runtime.GOOS != "windows" && mode&0o077 != 0 {
+		return Check{
+			Name:    name,
+			Status:  StatusWarn,
+			Message: fmt.Sprintf("%s has permissions %s and is readable by other users", path, mode),
+			Remedy:  fmt.Sprintf("Run: chmod 600 %s", path),
+		}
+	}
+	return Check{Name: name, Status: StatusPass, Message: fmt.Sprintf("%s has permissions %s", path, mode)}
+}
+
+func (d *doctor) checkEnvironment() {
+	const name = "Environment"
+	env, source := config.ResolveEnvironment()
+	settings, err := environment.GetEnvConfig(env)
+	if err != nil {
+		d.report.fail(name, "Run: pc config set environment production", "%s", err)
+		return
+	}
+	d.envSettings, d.envOK = settings, true
+	d.report.pass(name, "%s (from %s), API host %s", env, source, sdk.APIHostURL())
+}
+
+func (d *doctor) checkProxy() {
+	const name = "Proxy"
+	var set []string
+	if d.settings.Proxy != "" {
+		set = append(set, "proxy="+redactProxyURL(d.settings.Proxy))
+	}
+	if d.settings.NoProxy != "" {
+		set = append(set, "no-proxy="+d.settings.NoProxy)
+	}
+	for _, v := range []string{"HTTPS_PROXY", "HTTP_PROXY", "NO_PROXY"} {
+		if val := firstEnv(v, strings.ToLower(v)); val != "" {
+			set = append(set, fmt.Sprintf("%s=%s", v, redactProxyURL(val)))
+		}
+	}
+	if len(set) == 0 {
+		d.report.pass(name, "no proxy configured; connecting directly")
+		return
+	}
+	via := "directly"
+	if proxy := d.proxyFor(sdk.APIHostURL()); proxy != "" {
+		via = "through " + proxy
+	}
+	d.report.pass(name, "%s; the API is reached %s", strings.Join(set, ", "), via)
+}
+
+func (d *doctor) checkTLS() {
+	const name = "TLS settings"
+	s := d.settings
+	if s.CABundle == "" && s.ClientCert == "" && s.ClientKey == "" {
+		d.report.pass(name, "using the system CA certificates")
+		return
+	}
+	if _, err := s.TLSConfig(); err != nil {
+		d.report.fail(name, "Fix or unset ca-bundle, client-cert and client-key with pc config set/unset", "%s", err)
+		return
+	}
+	var parts []string
+	if s.CABundle != "" {
+		parts = append(parts, "CA bundle "+s.CABundle)
+	}
+	if s.ClientCert != "" {
+		parts = append(parts, "client certificate "+s.ClientCert)
+	}
+	d.report.pass(name, "using %s", strings.Join(parts, " and "))
+}
+
+func (d *doctor) checkCredentials(ctx context.Context) {
+	const name = "Credentials"
+	token, tokenErr := oauth.Token(ctx)
+	d.token = token
+
+	apiKey := secrets.GetDefaultAPIKey()
+	clientId, clientSecret := secrets.GetClientId(), secrets.GetClientSecret()
+	d.credentials = sdk.ResolveCredentialSource(apiKey, token, clientId, clientSecret)
+
+	var available []string
+	if apiKey != "" {
+		available = append(available, "API key "+credentialOrigin("PINECONE_API_KEY"))
+	}
+	if token != nil && token.AccessToken != "" {
+		available = append(available, "user login")
+	}
+	if clientId != "" && clientSecret != "" {
+		available = append(available, "service account "+credentialOrigin("PINECONE_CLIENT_ID"))
+	}
+
+	switch d.credentials {
+	case sdk.CredentialsNone:
+		if tokenErr != nil {
+			d.report.fail(name, "Run: pc login", "the stored login could not be refreshed: %s", tokenErr)
+			return
+		}
+		d.report.fail(name, "Run pc login, pc auth configure --client-id --client-secret, or pc config set api-key", "no credentials are configured")
+	case sdk.CredentialsAPIKey:
+		if len(available) > 1 {
+			d.report.warn(name, "To use your login instead, run: pc config unset api-key (or unset PINECONE_API_KEY)",
+				"using the API key %s; it takes priority over: %s", credentialOrigin("PINECONE_API_KEY"), strings.Join(available[1:], ", "))
+			return
+		}
+		d.report.pass(name, "using the API key %s", credentialOrigin("PINECONE_API_KEY"))
+	case sdk.CredentialsUserToken:
+		msg := "using your user login"
+		if email := state.GetTargetUserEmail(); email != "" {
+			msg += " (" + email + ")"
+		}
+		if len(available) > 1 {
+			msg += "; also configured: " + strings.Join(available[1:], ", ")
+		}
+		d.report.pass(name, "%s", msg)
+	case sdk.CredentialsServiceAccount:
+		d.report.pass(name, "using the service account %s", credentialOrigin("PINECONE_CLIENT_ID"))
+	}
+}
+
+// credentialOrigin describes where a credential bound to envVar comes from.
+func credentialOrigin(envVar string) string {
+	switch {
+	case secrets.UsingCredentialHelper():
+		return "from the credential helper " + config.CredentialHelper.Get()
+	case os.Getenv(envVar) != "":
+		return "from " + envVar
+	default:
+		return "stored in " + secrets.BackendName() + " secrets"
+	}
+}
+
+func (d *doctor) checkToken() {
+	const name = "Login token"
+	stored := secrets.GetOAuth2Token()
+	if stored.AccessToken == "" && stored.RefreshToken == "" {
+		d.report.skip(name, "not logged in")
+		return
+	}
+	if d.token == nil || d.token.AccessToken == "" {
+		d.report.fail(name, "Run: pc login", "the access token expired and could not be refreshed")
+		return
+	}
+	if d.token.Expiry.IsZero() {
+		d.report.pass(name, "valid, no expiry")
+		return
+	}
+	remaining := time.Until(d.token.Expiry).Round(time.Second)
+	if remaining <= 0 {
+		d.report.fail(name, "Run: pc login", "expired at %s", d.token.Expiry.Format(time.RFC3339))
+		return
+	}
+	refresh := "no refresh token; run pc login again when it expires"
+	if d.token.RefreshToken != "" {
+		refresh = "refreshed automatically"
+	}
+	d.report.pass(name, "valid for %s (%s)", remaining, refresh)
+}
+
+func (d *doctor) checkAPIHost(ctx context.Context) {
+	const name = "API reachability"
+	if !d.envOK {
+		d.report.skip(name, "the environment does not resolve")
+		return
+	}
+	probe := probeHost(ctx, d.httpClient, sdk.APIHostURL(), d.proxyFor(sdk.APIHostURL()) != "")
+	d.serverTime, d.localTime = probe.serverTime, probe.localTime
+	d.report.add(probe.check(name))
+}
+
+func (d *doctor) checkAuthHost(ctx context.Context) {
+	const name = "Login service reachability"
+	if !d.envOK {
+		d.report.skip(name, "the environment does not resolve")
+		return
+	}
+	if d.credentials == sdk.CredentialsAPIKey {
+		d.report.skip(name, "not needed with an API key")
+		return
+	}
+	if !d.envSettings.SupportsLogin() {
+		d.report.skip(name, "the environment does not support login")
+		return
+	}
+	d.report.add(probeHost(ctx, d.httpClient, d.envSettings.Auth0URL, d.proxyFor(d.envSettings.Auth0URL) != "").check(name))
+}
+
+func (d *doctor) checkClockSkew() {
+	const name = "Clock"
+	if d.serverTime.IsZero() {
+		d.report.skip(name, "the API did not report its time")
+		return
+	}
+	d.report.add(clockSkewCheck(name, d.localTime.Sub(d.serverTime)))
+}
+
+// clockSkewCheck grades the difference between local and server time. Login
+// tokens are rejected when the clock is far enough off.
+func clockSkewCheck(name string, skew time.Duration) Check {
+	abs := skew
+	if abs < 0 {
+		abs = -abs
+	}
+	direction := "ahead of"
+	if skew < 0 {
+		direction = "behind"
+	}
+	// The server's Date header has one second resolution.
+	if abs <= clockSkewWarn {
+		return Check{Name: name, Status: StatusPass, Message: "in sync with the Pinecone API"}
+	}
+	status := StatusWarn
+	if abs > clockSkewFail {
+		status = StatusFail
+	}
+	return Check{
+		Name:    name,
+		Status:  status,
+		Message: fmt.Sprintf("local clock is %s %s the Pinecone API", abs.Round(time.Second), direction),
+		Remedy:  "Enable time synchronization (NTP) on this machine",
+	}
+}
+
+func (d *doctor) checkTarget(ctx context.Context) {
+	const name = "Target"
+	if d.settingsErr != nil {
+		d.report.skip(name, "the connection settings are invalid")
+		return
+	}
+	org, orgSource := state.ResolveTargetOrg()
+	proj, projSource := state.ResolveTargetProject()
+	switch d.credentials {
+	case sdk.CredentialsNone:
+		d.report.skip(name, "no credentials")
+		return
+	case sdk.CredentialsAPIKey:
+		d.report.skip(name, "the API key determines the project")
+		return
+	}
+	if proj.Id == "" {
+		d.report.fail(name, "Run: pc target", "no target project is set")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	ac, err := sdk.TryNewPineconeAdminClient(ctx)
+	if err != nil {
+		d.report.fail(name, "Run: pc login", "cannot create an admin client: %s", err)
+		return
+	}
+	if org.Id != "" {
+		if _, err := ac.Organization.Describe(ctx, org.Id); err != nil {
+			d.report.fail(name, "Run: pc target --org <name> --project <name>", "organization %s (%s, from %s) is not accessible: %s", org.Name, org.Id, orgSource, describeErr(err))
+			return
+		}
+	}
+	if _, err := ac.Project.Describe(ctx, proj.Id); err != nil {
+		d.report.fail(name, "Run: pc target --project <name>", "project %s (%s, from %s) is not accessible: %s", proj.Name, proj.Id, projSource, describeErr(err))
+		return
+	}
+	d.report.pass(name, "organization %s, project %s (from %s)", org.Name, proj.Name, projSource)
+}
+
+func (d *doctor) checkManagedKeys(ctx context.Context) {
+	const name = "Managed API keys"
+	if d.settingsErr != nil {
+		d.report.skip(name, "the connection settings are invalid")
+		return
+	}
+	keys := secrets.GetManagedProjectKeys()
+	if len(keys) == 0 {
+		d.report.skip(name, "none stored")
+		return
+	}
+	if !d.envOK {
+		d.report.skip(name, "the environment does not resolve")
+		return
+	}
+
+	proj, _ := state.ResolveTargetProject()
+	var invalid []string
+	for projectId, key := range keys {
+		if projectId == proj.Id {
+			d.projectKey = key.Value
+		}
+		if key.Value == "" {
+			continue
+		}
+		if err := verifyKey(ctx, key.Value); err != nil {
+			if projectId == proj.Id {
+				d.projectKey = ""
+			}
+			invalid = append(invalid, fmt.Sprintf("%s (%s): %s", key.ProjectName, projectId, describeErr(err)))
+		}
+	}
+	if len(invalid) > 0 {
+		d.report.warn(name, "Remove the key with pc auth local-keys prune --id <project-id>; a new key is created on next use",
+			"%d of %d keys were rejected: %s", len(invalid), len(keys), strings.Join(invalid, "; "))
+		return
+	}
+	d.report.pass(name, "%d keys, all valid", len(keys))
+}
+
+func verifyKey(ctx context.Context, apiKey string) error {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	_, err := sdk.NewClientForAPIKey(apiKey).ListIndexes(ctx)
+	return err
+}
+
+func (d *doctor) checkIndexHosts(ctx context.Context) {
+	const name = "Index hosts"
+	if d.settingsErr != nil {
+		d.report.skip(name, "the connection settings are invalid")
+		return
+	}
+	apiKey := d.projectKey
+	if d.credentials == sdk.CredentialsAPIKey {
+		apiKey = secrets.GetDefaultAPIKey()
+	}
+	if apiKey == "" || !d.envOK {
+		d.report.skip(name, "no API key for the target project yet")
+		return
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	indexes, err := sdk.NewClientForAPIKey(apiKey).ListIndexes(listCtx)
+	if err != nil {
+		d.report.fail(name, "Check the API key and network access", "cannot list indexes: %s", describeErr(err))
+		return
+	}
+	if len(indexes) == 0 {
+		d.report.skip(name, "the project has no indexes")
+		return
+	}
+
+	var failed []string
+	probed := 0
+	for _, idx := range indexes {
+		if probed == maxIndexHosts {
+			break
+		}
+		host := idx.Host
+		if idx.PrivateHost != nil && *idx.PrivateHost != "" {
+			host = *idx.PrivateHost
+		}
+		if d.envSettings.IndexHost != "" {
+			host = d.envSettings.IndexHostFor(idx.Name)
+		}
+		if host == "" {
+			continue
+		}
+		probed++
+		if c := probeHost(ctx, d.httpClient, "https://"+host, d.proxyFor("https://"+host) != "").check(""); c.Status != StatusPass {
+			failed = append(failed, fmt.Sprintf("%s: %s", idx.Name, c.Message))
+		}
+	}
+	if len(failed) > 0 {
+		d.report.fail(name, "Check DNS, firewall and proxy settings for *.pinecone.io", "%d of %d index hosts unreachable: %s", len(failed), probed, strings.Join(failed, "; "))
+		return
+	}
+	d.report.pass(name, "%d of %d index hosts reachable", probed, len(indexes))
+}
+
+// hostProbe is the result of resolving and connecting to a host.
+type hostProbe struct {
+	host       string
+	dnsErr     error
+	proxied    bool
+	httpErr    error
+	latency    time.Duration
+	serverTime time.Time
+	localTime  time.Time
+}
+
+// probeHost resolves rawURL's host and makes a TLS request to it. Any HTTP
+// response, even an error status, shows the host is reachable.
+func probeHost(ctx context.Context, client *http.Client, rawURL string, proxied bool) hostProbe {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	p := hostProbe{host: rawURL, proxied: proxied}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		p.dnsErr = err
+		return p
+	}
+	p.host = u.Hostname()
+	if _, err := net.DefaultResolver.LookupHost(ctx, u.Hostname()); err != nil {
+		p.dnsErr = err
+		// Behind a proxy the proxy resolves the name; try the request anyway.
+		if !p.proxied {
+			return p
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		p.httpErr = err
+		return p
+	}
+	start := time.Now()
+	resp, err := client.Do(req)
+	p.latency = time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		p.httpErr = err
+		return p
+	}
+	resp.Body.Close()
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		p.serverTime = date
+		// Compare against the midpoint of the request.
+		p.localTime = start.Add(time.Since(start) / 2)
+	}
+	return p
+}
+
+func (p hostProbe) check(name string) Check {
+	switch {
+	case p.dnsErr != nil && !p.proxied:
+		return Check{Name: name, Status: StatusFail, Message: fmt.Sprintf("cannot resolve %s: %s", p.host, p.dnsErr), Remedy: "Check your DNS settings, or set a proxy with pc config set proxy <url>"}
+	case p.httpErr != nil:
+		return Check{Name: name, Status: StatusFail, Message: fmt.Sprintf("cannot connect to %s: %s", p.host, p.httpErr), Remedy: "Check firewall, proxy and TLS interception settings"}
+	default:
+		return Check{Name: name, Status: StatusPass, Message: fmt.Sprintf("%s reachable over TLS in %s", p.host, p.latency)}
+	}
+}
+
+// proxyFor returns the proxy used to reach rawURL, with any credentials
+// removed, or "" for a direct connection.
+func (d *doctor) proxyFor(rawURL string) string {
+	if proxy := d.settings.ProxyFor(rawURL); proxy != nil {
+		return proxy.Redacted()
+	}
+	return ""
+}
+
+func redactProxyURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.User == nil {
+		return raw
+	}
+	u.User = url.User("REDACTED")
+	return u.String()
+}
+
+func firstEnv(names ...string) string {
+	for _, n := range names {
+		if v := os.Getenv(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// describeErr shortens API errors to their classification and message.
+func describeErr(err error) string {
+	var pe *pinecone.PineconeError
+	if errors.As(err, &pe) {
+		return fmt.Sprintf("%s (HTTP %d)", clierr.Classify(err).Code, pe.Code)
+	}
+	return err.Error()
+}
+
+// platform describes the OS and architecture for bug reports.
+func platform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
@@ -0,0 +1,92 @@
+package doctor
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/pinecone-io/cli/internal/build"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
+	"github.com/spf13/cobra"
+)
+
+type doctorCmdOptions struct {
+	json bool
+}
+
+var (
+	doctorHelp = help.Long(`
+		Check the CLI's setup and connectivity, and suggest fixes for any problems.
+
+		The report covers the config directory and secrets file permissions, which
+		credential is used (an API key takes priority over a user login, which takes
+		priority over a service account), the login token, the target organization
+		and project, managed API keys, the environment, DNS and TLS reachability of
+		the API and index hosts, clock skew, and proxy and TLS settings.
+
+		Use --json to attach the report to a bug report. Secrets are never included.
+		The command exits with a non-zero status when any check fails.
+	`)
+)
+
+// newDoctor prepares the checks. Invalid connection settings are reported by
+// the TLS and proxy checks, so the probes fall back to the defaults rather
+// than exiting.
+func newDoctor(report *Report) *doctor {
+	settings := transport.CurrentSettings()
+	d := &doctor{report: report, settings: settings, httpClient: &http.Client{}}
+	if t, err := settings.NewTransport(); err != nil {
+		d.settingsErr = err
+	} else {
+		d.httpClient.Transport = t
+	}
+	return d
+}
+
+func NewDoctorCmd() *cobra.Command {
+	options := doctorCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose problems with the CLI's configuration and connectivity",
+		Long:  doctorHelp,
+		Example: help.Examples(`
+			pc doctor
+			pc doctor --json > pc-doctor.json
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			report := &Report{
+				Version:     build.Version,
+				Platform:    platform(),
+				Environment: config.GetEnvironment(),
+			}
+			d := newDoctor(report)
+
+			if options.json {
+				d.run(cmd.Context())
+				fmt.Fprintln(os.Stdout, text.IndentJSON(report))
+			} else {
+				_ = style.Spinner("Running checks", func() error {
+					d.run(cmd.Context())
+					return nil
+				})
+				printReport(os.Stdout, report)
+			}
+
+			if report.Failed() {
+				exit.Error(clierr.New(clierr.CodeUnknown, "%d checks failed", report.Summary.Fail), "pc doctor found problems")
+			}
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+package doctor
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+	StatusSkip Status = "skip"
+)
+
+// Check is one line of the doctor report. Remedy says how to fix a warning or
+// failure.
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+	Remedy  string `json:"remedy,omitempty"`
+}
+
+// Summary counts checks by status.
+type Summary struct {
+	Pass int `json:"pass"`
+	Warn int `json:"warn"`
+	Fail int `json:"fail"`
+	Skip int `json:"skip"`
+}
+
+// Report is the output of pc doctor, and the JSON document printed by --json.
+type Report struct {
+	Version     string  `json:"version"`
+	Platform    string  `json:"platform"`
+	Environment string  `json:"environment"`
+	Checks      []Check `json:"checks"`
+	Summary     Summary `json:"summary"`
+}
+
+func (r *Report) add(c Check) {
+	r.Checks = append(r.Checks, c)
+	switch c.Status {
+	case StatusPass:
+		r.Summary.Pass++
+	case StatusWarn:
+		r.Summary.Warn++
+	case StatusFail:
+		r.Summary.Fail++
+	case StatusSkip:
+		r.Summary.Skip++
+	}
+}
+
+func (r *Report) pass(name, format string, a ...any) {
+	r.add(Check{Name: name, Status: StatusPass, Message: fmt.Sprintf(format, a...)})
+}
+
+func (r *Report) skip(name, format string, a ...any) {
+	r.add(Check{Name: name, Status: StatusSkip, Message: fmt.Sprintf(format, a...)})
+}
+
+func (r *Report) warn(name, remedy, format string, a ...any) {
+	r.add(Check{Name: name, Status: StatusWarn, Message: fmt.Sprintf(format, a...), Remedy: remedy})
+}
+
+func (r *Report) fail(name, remedy, format string, a ...any) {
+	r.add(Check{Name: name, Status: StatusFail, Message: fmt.Sprintf(format, a...), Remedy: remedy})
+}
+
+// Failed reports whether any check failed.
+func (r *Report) Failed() bool {
+	return r.Summary.Fail > 0
+}
+
+func printReport(w io.Writer, r *Report) {
+	fmt.Fprintf(w, "pc %s (%s), environment %s\n\n", r.Version, r.Platform, r.Environment)
+	for _, c := range r.Checks {
+		fmt.Fprintf(w, "%s %s: %s\n", statusLabel(c.Status), c.Name, c.Message)
+		if c.Remedy != "" {
+			fmt.Fprintf(w, "       %s\n", style.Hint(c.Remedy))
+		}
+	}
+	fmt.Fprintf(w, "\n%d passed, %d warnings, %d failed, %d skipped\n", r.Summary.Pass, r.Summary.Warn, r.Summary.Fail, r.Summary.Skip)
+}
+
+func statusLabel(s Status) string {
+	label := fmt.Sprintf("[%s]", strings.ToUpper(string(s)))
+	switch s {
+	case StatusPass:
+		return style.StatusGreen(label)
+	case StatusWarn:
+		return style.StatusYellow(label)
+	case StatusFail:
+		return style.StatusRed(label)
+	default:
+		return style.Faint(label)
+	}
+}
@@ -10,13 +10,13 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 )
 
 type describeBackupCmdOptions struct {
 	backupId string
 	json     bool
+	output   presenters.OutputFormat
 }
 
 func NewDescribeBackupCmd() *cobra.Command {
@@ -37,7 +37,7 @@ func NewDescribeBackupCmd() *cobra.Command {
 
 			err := runDescribeBackupCmd(ctx, pc, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe backup: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe backup: %s\n", err)
 				exit.Error(err, "Failed to describe backup")
 			}
 		},
@@ -45,6 +45,7 @@ func NewDescribeBackupCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.backupId, "id", "i", "", "ID of the backup to describe")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 	_ = cmd.MarkFlagRequired("id")
 
 	return cmd
@@ -60,11 +61,9 @@ func runDescribeBackupCmd(ctx context.Context, svc BackupService, options descri
 		return err
 	}
 
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
+	presenters.PrintOutput(options.output.WithJSON(options.json), resp, func() {
 		presenters.PrintBackupTable(resp)
-	}
+	})
 
 	return nil
 }
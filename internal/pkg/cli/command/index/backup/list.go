@@ -2,14 +2,14 @@ package backup
 
 import (
 	"context"
-	"fmt"
+	"strconv"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +19,9 @@ type listBackupsCmdOptions struct {
 	limit           int
 	paginationToken string
 	json            bool
+	output          presenters.OutputFormat
+	list            presenters.ListOptions
+	watch           watch.Options
 }
 
 func NewListBackupsCmd() *cobra.Command {
@@ -29,6 +32,9 @@ func NewListBackupsCmd() *cobra.Command {
 		Short: "List backups for the current project",
 		Long: help.Long(`
 			List backups in the current project, optionally filtered by index name.
+
+			With --watch, the list is refreshed until every backup is ready or has
+			failed.
 		`),
 		Example: help.Examples(`
 			# List backups for the current project
@@ -36,6 +42,9 @@ func NewListBackupsCmd() *cobra.Command {
 
 			# List backups for a specific index
 			pc index backup list --index-name my-index --limit 10
+
+			# Follow backups until they are all ready
+			pc index backup list --index-name my-index --watch
 		`),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
@@ -43,7 +52,7 @@ func NewListBackupsCmd() *cobra.Command {
 
 			err := runListBackupsCmd(ctx, pc, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list backups: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list backups: %s\n", err)
 				exit.Error(err, "Failed to list backups")
 			}
 		},
@@ -53,6 +62,11 @@ func NewListBackupsCmd() *cobra.Command {
 	cmd.Flags().IntVarP(&options.limit, "limit", "l", 0, "Maximum number of backups to return")
 	cmd.Flags().StringVarP(&options.paginationToken, "pagination-token", "p", "", "Pagination token to continue a previous listing operation")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 
 	return cmd
 }
@@ -73,20 +87,37 @@ func runListBackupsCmd(ctx context.Context, svc BackupService, options listBacku
 		indexName = &options.indexName
 	}
 
-	resp, err := svc.ListBackups(ctx, &pinecone.ListBackupsParams{
-		IndexName:       indexName,
-		Limit:           limit,
-		PaginationToken: paginationToken,
+	format := options.output.WithJSON(options.json)
+	options.watch.Table = format.IsTable()
+	return watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+		resp, err := svc.ListBackups(ctx, &pinecone.ListBackupsParams{
+			IndexName:       indexName,
+			Limit:           limit,
+			PaginationToken: paginationToken,
+		})
+		if err != nil {
+			return watch.Frame{}, err
+		}
+		frame := watch.Frame{
+			Print: func() {
+				presenters.PrintList(format, options.list, resp, presenters.BackupListTable(resp), func(t presenters.Table) {
+					presenters.PrintBackupList(resp, t)
+				})
+			},
+			Done: true,
+		}
+		if resp != nil {
+			for _, backup := range resp.Data {
+				if backup == nil {
+					continue
+				}
+				frame.Values = append(frame.Values, watch.Value{Key: backup.BackupId + " status", Value: backup.Status})
+				if backup.RecordCount != nil {
+					frame.Values = append(frame.Values, watch.Value{Key: backup.BackupId + " record count", Value: strconv.Itoa(*backup.RecordCount)})
+				}
+				frame.Done = frame.Done && watch.IsFinal(backup.Status)
+			}
+		}
+		return frame, nil
 	})
-	if err != nil {
-		return err
-	}
-
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
-		presenters.PrintBackupList(resp)
-	}
-
-	return nil
 }
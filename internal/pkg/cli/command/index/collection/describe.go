@@ -1,21 +1,18 @@
 package collection
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 )
 
 type describeCollectionCmdOptions struct {
-	name string
-	json bool
+	name   string
+	json   bool
+	output presenters.OutputFormat
 }
 
 func NewDescribeCollectionCmd() *cobra.Command {
@@ -33,16 +30,13 @@ func NewDescribeCollectionCmd() *cobra.Command {
 
 			collection, err := pc.DescribeCollection(ctx, options.name)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe collection %s: %s\n", options.name, err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe collection %s: %s\n", options.name, err)
 				exit.Error(err, "Failed to describe collection")
 			}
 
-			if options.json {
-				json := text.IndentJSON(collection)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
+			presenters.PrintOutput(options.output.WithJSON(options.json), collection, func() {
 				presenters.PrintDescribeCollectionTable(collection)
-			}
+			})
 		},
 	}
 
@@ -52,6 +46,7 @@ func NewDescribeCollectionCmd() *cobra.Command {
 
 	// Optional flags
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	return cmd
 }
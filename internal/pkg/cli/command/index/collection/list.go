@@ -1,25 +1,23 @@
 package collection
 
 import (
-	"fmt"
-	"os"
 	"sort"
 	"strconv"
-	"strings"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
 type listCollectionsCmdOptions struct {
-	json bool
+	json   bool
+	output presenters.OutputFormat
+	list   presenters.ListOptions
 }
 
 func NewListCollectionsCmd() *cobra.Command {
@@ -37,7 +35,7 @@ func NewListCollectionsCmd() *cobra.Command {
 
 			collections, err := pc.ListCollections(ctx)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list collections: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list collections: %s\n", err)
 				exit.Error(err, "Failed to list collections")
 			}
 
@@ -46,31 +44,31 @@ func NewListCollectionsCmd() *cobra.Command {
 				return collections[i].Name < collections[j].Name
 			})
 
-			if options.json {
-				json := text.IndentJSON(collections)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
-				printTable(collections)
-			}
+			presenters.PrintList(options.output.WithJSON(options.json), options.list, collections, collectionTable(collections), presenters.PrintTable)
 		},
 	}
 
 	// Optional flags
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
 
 	return cmd
 }
 
-func printTable(collections []*pinecone.Collection) {
-	writer := presenters.NewTabWriter()
-
-	columns := []string{"NAME", "DIMENSION", "SIZE", "STATUS", "VECTORS", "ENVIRONMENT"}
-	header := strings.Join(columns, "\t") + "\n"
-	fmt.Fprint(writer, header)
-
+func collectionTable(collections []*pinecone.Collection) presenters.Table {
+	table := presenters.Table{
+		Resource: "collections",
+		Columns: []presenters.TableColumn{
+			{Header: "NAME"}, {Header: "DIMENSION"}, {Header: "SIZE"}, {Header: "STATUS"}, {Header: "VECTORS"}, {Header: "ENVIRONMENT"},
+		},
+	}
 	for _, coll := range collections {
 		values := []string{coll.Name, strconv.Itoa(int(coll.Dimension)), strconv.FormatInt(coll.Size, 10), string(coll.Status), strconv.Itoa(int(coll.VectorCount)), coll.Environment}
-		fmt.Fprint(writer, strings.Join(values, "\t")+"\n")
+		table.Rows = append(table.Rows, values)
+		table.Records = append(table.Records, coll)
 	}
-	writer.Flush()
+	return table
 }
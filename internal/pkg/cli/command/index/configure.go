@@ -143,6 +143,7 @@ func runConfigureIndexCmd(ctx context.Context, cmd *cobra.Command, options confi
 		msg.FailJSON(options.json, "Failed to configure index %s: %+v\n", style.Emphasis(options.indexName), err)
 		exit.Error(err, "Failed to configure index")
 	}
+	sdk.InvalidateIndex(options.indexName)
 
 	if options.json {
 		json := text.IndentJSON(idx)
@@ -122,6 +122,7 @@ func NewCreateIndexCmd() *cobra.Command {
 				msg.FailJSON(options.json, "Failed to create index: %s\n", err)
 				exit.Error(err, "Failed to create index")
 			}
+			sdk.InvalidateIndex(options.name)
 
 			renderSuccessOutput(idx, options)
 		},
@@ -55,13 +55,14 @@ func NewDeleteCmd() *cobra.Command {
 			err := runDeleteIndexCmd(ctx, pc, options)
 			if err != nil {
 				if strings.Contains(err.Error(), "not found") {
-					msg.FailJSON(options.json, "The index %s does not exist\n", style.Emphasis(options.indexName))
+					msg.FailJSONError(options.json, err, "The index %s does not exist\n", style.Emphasis(options.indexName))
 					exit.Errorf(err, "The index %s does not exist", style.Emphasis(options.indexName))
 				} else {
 					msg.FailJSON(options.json, "Failed to delete index %s: %s\n", style.Emphasis(options.indexName), err)
 					exit.Errorf(err, "Failed to delete index %s", style.Emphasis(options.indexName))
 				}
 			}
+			sdk.InvalidateIndex(options.indexName)
 		},
 	}
 
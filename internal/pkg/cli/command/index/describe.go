@@ -1,23 +1,28 @@
 package index
 
 import (
+	"context"
 	"fmt"
-	"os"
+	"strconv"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
 
 type describeCmdOptions struct {
 	indexName string
 	json      bool
+	output    presenters.OutputFormat
+	watch     watch.Options
 }
 
 func NewDescribeCmd() *cobra.Command {
@@ -28,6 +33,9 @@ func NewDescribeCmd() *cobra.Command {
 		Short: "Describe an index by name",
 		Example: help.Examples(`
 			pc index describe --index-name "index-name"
+
+			# Wait for a new or reconfigured index to become ready
+			pc index describe --index-name "index-name" --watch
 		`),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("index-name") && !cmd.Flags().Changed("name") {
@@ -39,23 +47,32 @@ func NewDescribeCmd() *cobra.Command {
 			ctx := cmd.Context()
 			pc := sdk.NewPineconeClient(ctx)
 
-			idx, err := pc.DescribeIndex(cmd.Context(), options.indexName)
+			format := options.output.WithJSON(options.json)
+			options.watch.Table = format.IsTable()
+			err := watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+				idx, err := pc.DescribeIndex(ctx, options.indexName)
+				if err != nil {
+					return watch.Frame{}, err
+				}
+				return watch.Frame{
+					Print: func() {
+						presenters.PrintOutput(format, idx, func() {
+							presenters.PrintDescribeIndexTable(idx)
+						})
+					},
+					Values: indexValues(idx),
+					Done:   idx.Status == nil || watch.IsFinal(string(idx.Status.State)),
+				}, nil
+			})
 			if err != nil {
 				if strings.Contains(err.Error(), "not found") {
-					msg.FailJSON(options.json, "The index %s does not exist\n", style.Emphasis(options.indexName))
+					msg.FailJSONError(options.output.WithJSON(options.json).IsJSON(), err, "The index %s does not exist\n", style.Emphasis(options.indexName))
 					exit.Errorf(err, "The index %s does not exist", style.Emphasis(options.indexName))
 				} else {
-					msg.FailJSON(options.json, "Failed to describe index %s: %s\n", style.Emphasis(options.indexName), err)
+					msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe index %s: %s\n", style.Emphasis(options.indexName), err)
 					exit.Errorf(err, "Failed to describe index %s", style.Emphasis(options.indexName))
 				}
 			}
-
-			if options.json {
-				json := text.IndentJSON(idx)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
-				presenters.PrintDescribeIndexTable(idx)
-			}
 		},
 	}
 
@@ -63,9 +80,24 @@ func NewDescribeCmd() *cobra.Command {
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of index to describe")
 	cmd.Flags().StringVarP(&options.indexName, "name", "n", "", "name of index to describe")
 	_ = cmd.Flags().MarkDeprecated("name", "use --index-name instead")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
 
 	// optional flags
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 
 	return cmd
 }
+
+// indexValues tracks the state of an index, which is final once it is ready
+// or has failed.
+func indexValues(idx *pinecone.Index) []watch.Value {
+	if idx.Status == nil {
+		return nil
+	}
+	return []watch.Value{
+		{Key: "state", Value: string(idx.Status.State)},
+		{Key: "ready", Value: strconv.FormatBool(idx.Status.Ready)},
+	}
+}
@@ -3,15 +3,18 @@ package index
 import (
 	"context"
 	"fmt"
-	"os"
+	"maps"
+	"slices"
+	"strconv"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/flags"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -20,6 +23,8 @@ type describeIndexStatsCmdOptions struct {
 	indexName string
 	filter    flags.JSONObject
 	json      bool
+	output    presenters.OutputFormat
+	watch     watch.Options
 }
 
 func NewDescribeIndexStatsCmd() *cobra.Command {
@@ -31,12 +36,18 @@ func NewDescribeIndexStatsCmd() *cobra.Command {
 			Return index statistics including dimension, total vector count, namespaces summary, and metadata field counts.
 			Use an optional metadata filter to restrict the scope of counts.
 
+			With --watch, the stats are refreshed until interrupted and changes in
+			vector counts are listed below the table.
+
 			JSON input may be inline, loaded from ./file.json, or read from stdin with '-'.
 		`),
 		Example: help.Examples(`
 			pc index stats --index-name "index-name"
 			pc index stats --index-name "index-name" --filter '{"genre":{"$eq":"rock"}}'
 			pc index stats --index-name "index-name" --filter ./filter.json
+
+			# Refresh the stats every 10 seconds while an import runs
+			pc index stats --index-name "index-name" --watch --interval 10s
 		`),
 		Run: func(cmd *cobra.Command, args []string) {
 			runDescribeIndexStatsCmd(cmd.Context(), options)
@@ -44,8 +55,11 @@ func NewDescribeIndexStatsCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of index to describe stats for")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
 	cmd.Flags().VarP(&options.filter, "filter", "f", "metadata filter to apply to the operation (inline JSON, ./path.json, or '-' for stdin)")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 	_ = cmd.MarkFlagRequired("index-name")
 
 	return cmd
@@ -56,7 +70,7 @@ func runDescribeIndexStatsCmd(ctx context.Context, options describeIndexStatsCmd
 
 	ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, "")
 	if err != nil {
-		msg.FailJSON(options.json, "Failed to create index connection: %s", err)
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create index connection: %s", err)
 		exit.Error(err, "Failed to create index connection")
 	}
 
@@ -65,21 +79,49 @@ func runDescribeIndexStatsCmd(ctx context.Context, options describeIndexStatsCmd
 	if options.filter != nil {
 		filter, err = pinecone.NewMetadataFilter(options.filter)
 		if err != nil {
-			msg.FailJSON(options.json, "Failed to create filter: %s", err)
+			msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create filter: %s", err)
 			exit.Errorf(err, "Failed to create filter")
 		}
 	}
 
-	resp, err := ic.DescribeIndexStatsFiltered(ctx, filter)
+	format := options.output.WithJSON(options.json)
+	options.watch.Table = format.IsTable()
+	err = watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+		resp, err := ic.DescribeIndexStatsFiltered(ctx, filter)
+		if err != nil {
+			return watch.Frame{}, err
+		}
+		return watch.Frame{
+			Print: func() {
+				presenters.PrintOutput(format, resp, func() {
+					presenters.PrintDescribeIndexStatsTable(resp)
+				})
+			},
+			Values: statsValues(resp),
+		}, nil
+	})
 	if err != nil {
-		msg.FailJSON(options.json, "Failed to describe stats: %s", err)
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe stats: %s", err)
 		exit.Error(err, "Failed to describe stats")
 	}
+}
 
-	if options.json {
-		json := text.IndentJSON(resp)
-		fmt.Fprintln(os.Stdout, json)
-	} else {
-		presenters.PrintDescribeIndexStatsTable(resp)
+// statsValues tracks the vector counts of the index and its namespaces.
+// Stats have no final state, so watching them runs until interrupted.
+func statsValues(resp *pinecone.DescribeIndexStatsResponse) []watch.Value {
+	values := []watch.Value{{Key: "total vector count", Value: strconv.FormatUint(uint64(resp.TotalVectorCount), 10)}}
+	names := slices.Sorted(maps.Keys(resp.Namespaces))
+	for _, name := range names {
+		if summary := resp.Namespaces[name]; summary != nil {
+			label := name
+			if label == "" {
+				label = `""`
+			}
+			values = append(values, watch.Value{
+				Key:   fmt.Sprintf("namespace %s vector count", label),
+				Value: strconv.FormatUint(uint64(summary.VectorCount), 10),
+			})
+		}
 	}
+	return values
 }
@@ -22,7 +22,8 @@ var importHelp = help.Long(`
 	push records through the upsert API. For secure data sources, you can configure a 
 	storage integration through the Pinecone console.
 
-	Use these commands to start, describe, list, and cancel import operations.
+	Use these commands to prepare and check import data, and to start,
+	describe, list, and cancel import operations.
 
 	Docs:
 	  Import data:          https://docs.pinecone.io/guides/index-data/import-data
@@ -37,6 +38,12 @@ func NewImportCmd() *cobra.Command {
 		Long:    importHelp,
 		GroupID: help.GROUP_INDEX_MANAGEMENT.ID,
 		Example: help.Examples(`
+			# Convert JSONL vectors into Parquet files to import
+			pc index import prepare --input ./vectors.jsonl --output ./import/
+
+			# Check the data before importing it
+			pc index import validate --uri s3://my-bucket/data/ --index-name my-index
+
 			# Start an import from an S3 URI
 			pc index import start --index-name my-index --uri s3://my-bucket/data/
 
@@ -51,6 +58,8 @@ func NewImportCmd() *cobra.Command {
 		`),
 	}
 
+	cmd.AddCommand(NewPrepareImportCmd())
+	cmd.AddCommand(NewValidateImportCmd())
 	cmd.AddCommand(NewStartImportCmd())
 	cmd.AddCommand(NewDescribeImportCmd())
 	cmd.AddCommand(NewListImportsCmd())
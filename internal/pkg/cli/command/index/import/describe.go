@@ -3,14 +3,17 @@ package importcmd
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +21,8 @@ type describeImportCmdOptions struct {
 	indexName string
 	importId  string
 	json      bool
+	output    presenters.OutputFormat
+	watch     watch.Options
 }
 
 // NewDescribeImportCmd returns the "import describe" subcommand.
@@ -30,30 +35,39 @@ func NewDescribeImportCmd() *cobra.Command {
 		Long: help.Long(`
 			Show the current status and details of an import operation, including
 			percent complete, records imported, and any error messages.
+
+			With --watch, the import is refreshed until it completes, fails or is
+			cancelled.
 		`),
 		Example: help.Examples(`
 			pc index import describe --index-name my-index --id import-123
+
+			# Follow an import until it finishes
+			pc index import describe --index-name my-index --id import-123 --watch
 		`),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
 			pc := sdk.NewPineconeClient(ctx)
 			ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, "")
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to connect to index: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to connect to index: %s\n", err)
 				exit.Error(err, "Failed to connect to index")
 			}
 
 			err = runDescribeImportCmd(ctx, ic, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe import: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe import: %s\n", err)
 				exit.Error(err, "Failed to describe import")
 			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "Name of the index the import belongs to")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
 	cmd.Flags().StringVar(&options.importId, "id", "", "ID of the import to describe")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 	_ = cmd.MarkFlagRequired("index-name")
 	_ = cmd.MarkFlagRequired("id")
 
@@ -65,16 +79,33 @@ func runDescribeImportCmd(ctx context.Context, svc ImportService, options descri
 		return fmt.Errorf("--id is required")
 	}
 
-	resp, err := svc.DescribeImport(ctx, options.importId)
-	if err != nil {
-		return err
-	}
+	format := options.output.WithJSON(options.json)
+	options.watch.Table = format.IsTable()
+	return watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+		resp, err := svc.DescribeImport(ctx, options.importId)
+		if err != nil {
+			return watch.Frame{}, err
+		}
+		return watch.Frame{
+			Print: func() {
+				presenters.PrintOutput(format, resp, func() {
+					presenters.PrintImportTable(resp)
+				})
+			},
+			Values: importValues(resp),
+			Done:   resp == nil || watch.IsFinal(string(resp.Status)),
+		}, nil
+	})
+}
 
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
-		presenters.PrintImportTable(resp)
+// importValues tracks the progress of an import.
+func importValues(imp *pinecone.Import) []watch.Value {
+	if imp == nil {
+		return nil
+	}
+	return []watch.Value{
+		{Key: imp.Id + " status", Value: string(imp.Status)},
+		{Key: imp.Id + " percent complete", Value: fmt.Sprintf("%.1f%%", imp.PercentComplete)},
+		{Key: imp.Id + " records imported", Value: strconv.FormatInt(imp.RecordsImported, 10)},
 	}
-
-	return nil
 }
@@ -2,14 +2,14 @@ package importcmd
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +18,9 @@ type listImportsCmdOptions struct {
 	limit           int
 	paginationToken string
 	json            bool
+	output          presenters.OutputFormat
+	list            presenters.ListOptions
+	watch           watch.Options
 }
 
 // NewListImportsCmd returns the "import list" subcommand.
@@ -29,6 +32,9 @@ func NewListImportsCmd() *cobra.Command {
 		Short: "List import operations for an index",
 		Long: help.Long(`
 			List bulk import operations for the given index, with optional pagination.
+
+			With --watch, the list is refreshed until every import has completed,
+			failed or been cancelled.
 		`),
 		Example: help.Examples(`
 			# List all imports for an index
@@ -39,28 +45,37 @@ func NewListImportsCmd() *cobra.Command {
 
 			# Continue paginating from a previous call
 			pc index import list --index-name my-index --pagination-token <token>
+
+			# Follow all imports until they finish
+			pc index import list --index-name my-index --watch
 		`),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
 			pc := sdk.NewPineconeClient(ctx)
 			ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, "")
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to connect to index: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to connect to index: %s\n", err)
 				exit.Error(err, "Failed to connect to index")
 			}
 
 			err = runListImportsCmd(ctx, ic, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list imports: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list imports: %s\n", err)
 				exit.Error(err, "Failed to list imports")
 			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "Name of the index to list imports for")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
 	cmd.Flags().IntVarP(&options.limit, "limit", "l", 0, "Maximum number of imports to return")
 	cmd.Flags().StringVarP(&options.paginationToken, "pagination-token", "p", "", "Pagination token to continue a previous listing operation")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 	_ = cmd.MarkFlagRequired("index-name")
 
 	return cmd
@@ -78,16 +93,27 @@ func runListImportsCmd(ctx context.Context, svc ImportService, options listImpor
 		paginationToken = &options.paginationToken
 	}
 
-	resp, err := svc.ListImports(ctx, limit, paginationToken)
-	if err != nil {
-		return err
-	}
-
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
-		presenters.PrintImportList(resp)
-	}
-
-	return nil
+	format := options.output.WithJSON(options.json)
+	options.watch.Table = format.IsTable()
+	return watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+		resp, err := svc.ListImports(ctx, limit, paginationToken)
+		if err != nil {
+			return watch.Frame{}, err
+		}
+		frame := watch.Frame{
+			Print: func() {
+				presenters.PrintList(format, options.list, resp, presenters.ImportListTable(resp), func(t presenters.Table) {
+					presenters.PrintImportList(resp, t)
+				})
+			},
+			Done: true,
+		}
+		if resp != nil {
+			for _, imp := range resp.Imports {
+				frame.Values = append(frame.Values, importValues(imp)...)
+				frame.Done = frame.Done && (imp == nil || watch.IsFinal(string(imp.Status)))
+			}
+		}
+		return frame, nil
+	})
 }
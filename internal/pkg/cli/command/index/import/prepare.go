@@ -0,0 +1,196 @@
+package importcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/bulkimport"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/spf13/cobra"
+)
+
+type prepareImportCmdOptions struct {
+	input        string
+	output       string
+	namespace    string
+	indexName    string
+	dimension    int
+	vectorType   string
+	rowGroupSize int
+	rowsPerFile  int
+	json         bool
+}
+
+// NewPrepareImportCmd returns the "import prepare" subcommand.
+func NewPrepareImportCmd() *cobra.Command {
+	options := prepareImportCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "prepare",
+		Short: "Convert JSON or JSONL vectors into Parquet files ready to import",
+		Long: help.Long(`
+			Convert vectors in the JSON and JSONL formats of "pc index vector upsert"
+			into Parquet files laid out the way an import reads them, for data too
+			large to upsert. Upload the output directory to object storage, e.g.
+			with "aws s3 sync", and start an import from its URI.
+
+			The output has a directory per namespace, with __default__ for the
+			default namespace, of files with an id column, a values column for
+			dense vectors, and sparse_values and metadata columns. Each namespace
+			continues in a new file every --rows-per-file rows. A manifest.json at
+			the root lists the namespaces and files; imports ignore it.
+
+			--input is a .json or .jsonl file, '-' for stdin, or a directory. Files
+			directly in a directory go to --namespace, and files in a subdirectory
+			go to the namespace the subdirectory is named after.
+
+			Vectors are checked like "pc index import validate" checks rows: against
+			the dimension and vector type of --index-name, or else of the first
+			vector. The output directory must be empty or not exist; when a vector
+			is invalid, or the conversion is interrupted, the files written so far
+			are removed. --timeout doesn't apply, since no requests are made
+			without --index-name.
+		`),
+		Example: help.Examples(`
+			# Convert a JSONL file into the default namespace
+			pc index import prepare --input ./vectors.jsonl --output ./import/
+
+			# Convert a directory with a subdirectory per namespace, checking
+			# vectors against an index
+			pc index import prepare --input ./vectors/ --output ./import/ --index-name my-index
+
+			# Upload the files and import them
+			aws s3 sync ./import/ s3://my-bucket/data/
+			pc index import start --index-name my-index --uri s3://my-bucket/data/
+		`),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			manifest, err := runPrepareImportCmd(ctx, options)
+			if err != nil {
+				msg.FailJSON(options.json, "Failed to prepare import data: %s\n", err)
+				exit.Error(err, "Failed to prepare import data")
+			}
+
+			if options.json {
+				fmt.Println(text.IndentJSON(manifest))
+				return
+			}
+			presenters.PrintImportManifest(options.output, manifest)
+			msg.SuccessMsg("Wrote %d vectors to %d files in %s", manifest.Rows, manifest.Files, style.Emphasis(options.output))
+			msg.HintMsg("Upload the files with %s, then run %s",
+				style.Code("aws s3 sync "+options.output+" s3://<bucket>/<path>/"),
+				style.Code("pc index import start --index-name <index> --uri s3://<bucket>/<path>/"))
+		},
+	}
+
+	cmd.Flags().StringVar(&options.input, "input", "", "JSON or JSONL file of vectors, '-' for stdin, or a directory of them")
+	cmd.Flags().StringVar(&options.output, "output", "", "Directory to write the Parquet files and manifest to")
+	cmd.Flags().StringVar(&options.namespace, "namespace", "", "Namespace of the vectors of --input files, instead of the default namespace")
+	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "Name of the index to check the vectors against")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
+	cmd.Flags().IntVar(&options.dimension, "dimension", 0, "Dimension of the vectors, instead of the index's")
+	cmd.Flags().StringVar(&options.vectorType, "vector-type", "", "Vector type of the index, dense or sparse, instead of the index's")
+	cmd.Flags().IntVar(&options.rowGroupSize, "row-group-size", bulkimport.DefaultRowGroupSize, "Rows of each Parquet row group")
+	cmd.Flags().IntVar(&options.rowsPerFile, "rows-per-file", bulkimport.DefaultRowsPerFile, "Rows of each Parquet file")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output the manifest as JSON")
+	_ = cmd.MarkFlagRequired("input")
+	_ = cmd.MarkFlagRequired("output")
+
+	return cmd
+}
+
+func runPrepareImportCmd(ctx context.Context, options prepareImportCmdOptions) (*bulkimport.Manifest, error) {
+	switch options.vectorType {
+	case "", "dense", "sparse":
+	default:
+		return nil, clierr.New(clierr.CodeInvalidArgument, "--vector-type must be dense or sparse, got %q", options.vectorType)
+	}
+	if options.rowGroupSize <= 0 || options.rowsPerFile <= 0 {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "--row-group-size and --rows-per-file must be positive")
+	}
+
+	sources, err := bulkimport.Sources(options.input, options.namespace)
+	if err != nil {
+		return nil, clierr.Wrap(clierr.CodeInvalidArgument, err)
+	}
+	dimension, vectorType, err := indexShape(ctx, options.indexName, options.dimension, options.vectorType)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := bulkimport.NewWriter(options.output, bulkimport.PrepareOptions{
+		Dimension:    dimension,
+		VectorType:   vectorType,
+		RowGroupSize: options.rowGroupSize,
+		RowsPerFile:  options.rowsPerFile,
+	})
+	if err != nil {
+		return nil, clierr.Wrap(clierr.CodeInvalidArgument, err)
+	}
+
+	// The conversion is local and can take longer than --timeout, so it
+	// stops on an interrupt rather than when ctx is done.
+	sigCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	manifest, err := writeSources(sigCtx, w, sources)
+	if err != nil {
+		if abortErr := w.Abort(); abortErr != nil {
+			log.Warn().Err(abortErr).Str("output", options.output).Msg("Failed to remove partial import data")
+		}
+		return nil, err
+	}
+	return manifest, nil
+}
+
+func writeSources(ctx context.Context, w *bulkimport.Writer, sources []bulkimport.Source) (*bulkimport.Manifest, error) {
+	for _, source := range sources {
+		if err := prepareSource(ctx, w, source); err != nil {
+			return nil, err
+		}
+	}
+	return w.Close()
+}
+
+func prepareSource(ctx context.Context, w *bulkimport.Writer, source bulkimport.Source) error {
+	var r io.Reader = os.Stdin
+	label := "stdin"
+	if source.Path != "-" {
+		f, err := os.Open(source.Path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r, label = f, source.Path
+	}
+	w.AddSource(label)
+
+	err := bulkimport.ReadVectors(r, func(v *pinecone.Vector) error {
+		if ctx.Err() != nil {
+			return clierr.New(clierr.CodeCanceled, "interrupted")
+		}
+		return w.Write(source.Namespace, v)
+	})
+	var cerr *clierr.Error
+	if errors.As(err, &cerr) && cerr.Code == clierr.CodeCanceled {
+		return cerr
+	}
+	if err != nil {
+		return clierr.Wrap(clierr.CodeInvalidArgument, fmt.Errorf("%s: %w", label, err))
+	}
+	return nil
+}
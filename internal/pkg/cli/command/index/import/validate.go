@@ -0,0 +1,153 @@
+package importcmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/bulkimport"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+type validateImportCmdOptions struct {
+	uri        string
+	endpoint   string
+	indexName  string
+	dimension  int
+	vectorType string
+	sampleRows int
+	json       bool
+}
+
+// NewValidateImportCmd returns the "import validate" subcommand.
+func NewValidateImportCmd() *cobra.Command {
+	options := validateImportCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check import data before starting an import",
+		Long: help.Long(`
+			Check that data is laid out the way an import reads it, before starting
+			an import that would fail hours later:
+
+			  - Parquet files are in one directory per namespace under the URI, with
+			    __default__ for the default namespace
+			  - files have an id column (STRING), a values column (LIST of FLOAT) for
+			    dense indexes, and optional sparse_values (STRUCT of indices and
+			    values lists) and metadata (STRING of JSON) columns
+			  - every row has an ID, as many values as the index dimension, matching
+			    sparse indices and values, and metadata that is a JSON object
+
+			--uri is an s3:// URI, or a local directory holding a copy of the data.
+			S3 objects are read with the credentials of the AWS_ACCESS_KEY_ID,
+			AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables or the
+			AWS_PROFILE of ~/.aws/credentials, and anonymously without any. Use
+			--endpoint, or AWS_ENDPOINT_URL_S3, for S3-compatible storage such as
+			MinIO.
+
+			With --index-name, rows are checked against the index dimension and
+			vector type; otherwise every row must have as many values as the first.
+			Only the first --sample-rows rows of each file are read.
+
+			Exits with status 2 when errors are found; warnings are data the import
+			skips.
+		`),
+		Example: help.Examples(`
+			# Check data in S3 against an index
+			pc index import validate --uri s3://my-bucket/data/ --index-name my-index
+
+			# Check a local copy of the data, reading every row
+			pc index import validate --uri ./data/ --dimension 1536 --sample-rows 0
+
+			# Check data in MinIO
+			pc index import validate --uri s3://my-bucket/data/ --endpoint http://localhost:9000
+		`),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			report, err := runValidateImportCmd(ctx, options)
+			if err != nil {
+				msg.FailJSON(options.json, "Failed to validate import data: %s\n", err)
+				exit.Error(err, "Failed to validate import data")
+			}
+
+			if options.json {
+				fmt.Println(text.IndentJSON(report))
+			} else {
+				presenters.PrintImportValidationReport(report)
+			}
+
+			if errs := report.Count(bulkimport.SeverityError); errs > 0 {
+				if !options.json {
+					msg.FailMsg("Found %d error(s) in %s", errs, style.Emphasis(report.Location))
+				}
+				exit.Error(clierr.New(clierr.CodeInvalidArgument, "found %d error(s) in import data", errs), "Import data is invalid")
+			}
+			if !options.json {
+				msg.SuccessMsg("%s is ready to import", style.Emphasis(report.Location))
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.uri, "uri", "u", "", "URI of the data to check (s3://bucket/path/), or a local directory")
+	cmd.Flags().StringVar(&options.endpoint, "endpoint", "", "URL of an S3-compatible endpoint, e.g. http://localhost:9000")
+	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "Name of the index to check the data against")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
+	cmd.Flags().IntVar(&options.dimension, "dimension", 0, "Dimension of the vectors, instead of the index's")
+	cmd.Flags().StringVar(&options.vectorType, "vector-type", "", "Vector type of the index, dense or sparse, instead of the index's")
+	cmd.Flags().IntVar(&options.sampleRows, "sample-rows", 1000, "Rows of each file to check; 0 checks every row")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	_ = cmd.MarkFlagRequired("uri")
+
+	return cmd
+}
+
+func runValidateImportCmd(ctx context.Context, options validateImportCmdOptions) (*bulkimport.Report, error) {
+	switch options.vectorType {
+	case "", "dense", "sparse":
+	default:
+		return nil, clierr.New(clierr.CodeInvalidArgument, "--vector-type must be dense or sparse, got %q", options.vectorType)
+	}
+	if options.sampleRows < 0 {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "--sample-rows can't be negative")
+	}
+
+	dimension, vectorType, err := indexShape(ctx, options.indexName, options.dimension, options.vectorType)
+	if err != nil {
+		return nil, err
+	}
+	vo := bulkimport.ValidateOptions{Dimension: dimension, Sparse: vectorType == "sparse", SampleRows: options.sampleRows}
+
+	storage, err := bulkimport.OpenStorage(options.uri, options.endpoint)
+	if err != nil {
+		return nil, clierr.Wrap(clierr.CodeInvalidArgument, err)
+	}
+	return bulkimport.Validate(ctx, storage, vo)
+}
+
+// indexShape returns the dimension and vector type of the index named
+// indexName, or of neither when it's empty, overridden by the dimension and
+// vectorType flags when they're set.
+func indexShape(ctx context.Context, indexName string, dimension int, vectorType string) (int, string, error) {
+	if indexName != "" {
+		pc := sdk.NewPineconeClient(ctx)
+		idx, err := sdk.DescribeIndex(ctx, pc, indexName)
+		if err != nil {
+			return 0, "", fmt.Errorf("failed to describe index %s: %w", indexName, err)
+		}
+		if dimension <= 0 && idx.Dimension != nil {
+			dimension = int(*idx.Dimension)
+		}
+		if vectorType == "" {
+			vectorType = idx.VectorType
+		}
+	}
+	return dimension, vectorType, nil
+}
@@ -2,7 +2,6 @@ package index
 
 import (
 	"fmt"
-	"os"
 	"sort"
 	"strings"
 
@@ -11,15 +10,16 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
 type listIndexCmdOptions struct {
-	json bool
-	wide bool
+	json   bool
+	output presenters.OutputFormat
+	list   presenters.ListOptions
+	wide   bool
 }
 
 func NewListCmd() *cobra.Command {
@@ -37,7 +37,7 @@ func NewListCmd() *cobra.Command {
 
 			idxs, err := pc.ListIndexes(ctx)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list indexes: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list indexes: %s\n", err)
 				exit.Error(err, "Failed to list indexes")
 			}
 
@@ -46,31 +46,39 @@ func NewListCmd() *cobra.Command {
 				return idxs[i].Name < idxs[j].Name
 			})
 
-			if options.json {
-				json := text.IndentJSON(idxs)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
-				printTable(idxs, options.wide)
-			}
+			// --columns can pick any column, so build the table with the wide columns
+			wide := options.wide || len(options.list.Columns) > 0
+			presenters.PrintList(options.output.WithJSON(options.json), options.list, idxs, indexTable(idxs, wide), func(t presenters.Table) {
+				presenters.PrintTable(t)
+				if !wide {
+					msg.HintMsg("Use --wide to show host/embed/tags, or --json for full details.")
+				}
+			})
 		},
 	}
 
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON, includes full index details")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
 	cmd.Flags().BoolVarP(&options.wide, "wide", "w", false, "Show additional columns (host, embed, tags)")
 
 	return cmd
 }
 
-// printTable prints the index list in a table format
-func printTable(idxs []*pinecone.Index, wide bool) {
-	writer := presenters.NewTabWriter()
-
-	columns := []string{"NAME", "STATUS", "SPEC", "CLOUD/REGION", "METRIC", "DIMENSION", "READ CAPACITY", "HOST"}
+// indexTable returns the index list in a table format
+func indexTable(idxs []*pinecone.Index, wide bool) presenters.Table {
+	table := presenters.Table{
+		Resource: "indexes",
+		Columns: []presenters.TableColumn{
+			{Header: "NAME"}, {Header: "STATUS"}, {Header: "SPEC"}, {Header: "CLOUD/REGION"}, {Header: "METRIC"},
+			{Header: "DIMENSION"}, {Header: "READ CAPACITY"}, {Header: "HOST"},
+		},
+	}
 	if wide {
-		columns = append(columns, "EMBED", "TAGS")
+		table.Columns = append(table.Columns, presenters.TableColumn{Header: "EMBED"}, presenters.TableColumn{Header: "TAGS"})
 	}
-	header := strings.Join(columns, "\t") + "\n"
-	fmt.Fprint(writer, header)
 
 	for _, idx := range idxs {
 		status := "-"
@@ -94,13 +102,10 @@ func printTable(idxs []*pinecone.Index, wide bool) {
 		if wide {
 			values = append(values, embed, tags)
 		}
-		fmt.Fprint(writer, strings.Join(values, "\t")+"\n")
-	}
-
-	writer.Flush()
-	if !wide {
-		msg.HintMsg("Use --wide to show host/embed/tags, or --json for full details.")
+		table.Rows = append(table.Rows, values)
+		table.Records = append(table.Records, idx)
 	}
+	return table
 }
 
 // formatSpec formats the index spec as "serverless", "byoc", or "pod"
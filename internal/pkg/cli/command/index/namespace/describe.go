@@ -3,15 +3,14 @@ package namespace
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +18,7 @@ type describeNamespaceCmdOptions struct {
 	indexName string
 	name      string
 	json      bool
+	output    presenters.OutputFormat
 }
 
 func NewDescribeNamespaceCmd() *cobra.Command {
@@ -45,27 +45,29 @@ func NewDescribeNamespaceCmd() *cobra.Command {
 			pc := sdk.NewPineconeClient(ctx)
 
 			if strings.TrimSpace(options.indexName) == "" {
-				msg.FailJSON(options.json, "Failed to describe namespace: --index-name is required")
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe namespace: --index-name is required")
 				exit.ErrorMsg("Failed to describe namespace: --index-name is required")
 			}
 
 			ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, "")
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe namespace: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe namespace: %s\n", err)
 				exit.Error(err, "Failed to describe namespace")
 			}
 
 			err = runDescribeNamespaceCmd(ctx, ic, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe namespace: %s", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe namespace: %s", err)
 				exit.Error(err, "Failed to describe namespace")
 			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of the index to describe the namespace from")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
 	cmd.Flags().StringVar(&options.name, "name", "", "name of the namespace to describe (use \"__default__\" for the default namespace)")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 	_ = cmd.MarkFlagRequired("index-name")
 	_ = cmd.MarkFlagRequired("name")
 
@@ -82,12 +84,9 @@ func runDescribeNamespaceCmd(ctx context.Context, ic NamespaceService, options d
 		return err
 	}
 
-	if options.json {
-		json := text.IndentJSON(ns)
-		fmt.Fprintln(os.Stdout, json)
-	} else {
+	presenters.PrintOutput(options.output.WithJSON(options.json), ns, func() {
 		presenters.PrintDescribeNamespaceTable(ns)
-	}
+	})
 
 	return nil
 }
@@ -3,9 +3,9 @@ package namespace
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
@@ -22,6 +22,8 @@ type listNamespaceCmdOptions struct {
 	limit           uint32
 	prefix          string
 	json            bool
+	output          presenters.OutputFormat
+	list            presenters.ListOptions
 }
 
 func NewListNamespaceCmd() *cobra.Command {
@@ -52,29 +54,34 @@ func NewListNamespaceCmd() *cobra.Command {
 			pc := sdk.NewPineconeClient(ctx)
 
 			if strings.TrimSpace(options.indexName) == "" {
-				msg.FailJSON(options.json, "Failed to list namespaces: --index-name is required")
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list namespaces: --index-name is required")
 				exit.ErrorMsg("Failed to list namespaces: --index-name is required")
 			}
 
 			ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, "")
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list namespaces: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list namespaces: %s\n", err)
 				exit.Error(err, "Failed to list namespaces")
 			}
 
 			err = runListNamespaceCmd(ctx, ic, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list namespaces: %s", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list namespaces: %s", err)
 				exit.Error(err, "Failed to list namespaces")
 			}
 		},
 	}
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of the index to list namespaces from")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name")
 	cmd.Flags().StringVarP(&options.paginationToken, "pagination-token", "p", "", "pagination token to continue a previous listing operation")
 	cmd.Flags().Uint32VarP(&options.limit, "limit", "l", 0, "maximum number of namespaces to list")
 	cmd.Flags().StringVar(&options.prefix, "prefix", "", "prefix to filter namespaces by")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
 
 	_ = cmd.MarkFlagRequired("index-name")
 
@@ -108,17 +115,41 @@ func runListNamespaceCmd(ctx context.Context, ic NamespaceService, options listN
 		return err
 	}
 
-	if options.json {
-		json := text.IndentJSON(resp)
-		fmt.Fprintln(os.Stdout, json)
-	} else {
-		printTable(resp)
-	}
+	presenters.PrintList(options.output.WithJSON(options.json), options.list, resp, namespaceTable(resp), func(t presenters.Table) {
+		printTable(resp, t)
+	})
 
 	return nil
 }
 
-func printTable(resp *pinecone.ListNamespacesResponse) {
+func namespaceTable(resp *pinecone.ListNamespacesResponse) presenters.Table {
+	table := presenters.Table{
+		Resource:  "namespaces",
+		ListField: "Namespaces",
+		Columns: []presenters.TableColumn{
+			{Header: "NAME"}, {Header: "RECORD COUNT"}, {Header: "INDEXED FIELDS"}, {Header: "SCHEMA"},
+		},
+	}
+	if resp == nil {
+		return table
+	}
+
+	for _, ns := range resp.Namespaces {
+		schema := "<none>"
+		if ns.Schema != nil {
+			schema = text.InlineJSON(ns.Schema)
+		}
+		indexedFields := "<none>"
+		if ns.IndexedFields != nil {
+			indexedFields = text.InlineJSON(ns.IndexedFields)
+		}
+		table.Rows = append(table.Rows, []string{ns.Name, fmt.Sprintf("%d", ns.RecordCount), indexedFields, schema})
+		table.Records = append(table.Records, ns)
+	}
+	return table
+}
+
+func printTable(resp *pinecone.ListNamespacesResponse, table presenters.Table) {
 	writer := presenters.NewTabWriter()
 	if resp == nil {
 		presenters.PrintEmptyState(writer, "namespaces")
@@ -133,22 +164,8 @@ func printTable(resp *pinecone.ListNamespacesResponse) {
 	}
 	fmt.Fprintf(writer, "Next Pagination Token: %s\n", pgToken)
 	fmt.Fprintf(writer, "\n")
+	writer.Flush()
 
 	// Namespaces table
-	columns := []string{"NAME", "RECORD COUNT", "INDEXED FIELDS", "SCHEMA"}
-	header := strings.Join(columns, "\t") + "\n"
-	fmt.Fprint(writer, header)
-
-	for _, ns := range resp.Namespaces {
-		schema := "<none>"
-		if ns.Schema != nil {
-			schema = text.InlineJSON(ns.Schema)
-		}
-		indexedFields := "<none>"
-		if ns.IndexedFields != nil {
-			indexedFields = text.InlineJSON(ns.IndexedFields)
-		}
-		fmt.Fprintf(writer, "%s\t%d\t%s\t%s\n", ns.Name, ns.RecordCount, indexedFields, schema)
-	}
-	writer.Flush()
+	presenters.PrintTable(table)
 }
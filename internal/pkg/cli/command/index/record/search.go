@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/argio"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/flags"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
@@ -13,7 +14,6 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
@@ -34,6 +34,7 @@ type searchCmdOptions struct {
 	fields        flags.StringList
 	body          string
 	json          bool
+	output        presenters.OutputFormat
 }
 
 func NewSearchCmd() *cobra.Command {
@@ -93,11 +94,11 @@ func NewSearchCmd() *cobra.Command {
 			pc := sdk.NewPineconeClient(ctx)
 			ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, options.namespace)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to create index connection: %s", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create index connection: %s", err)
 				exit.Error(err, "Failed to create index connection")
 			}
 			if err := runSearchCmd(ctx, ic, options); err != nil {
-				msg.FailJSON(options.json, "%s", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "%s", err)
 				exit.Error(err, "search failed")
 			}
 		},
@@ -105,6 +106,7 @@ func NewSearchCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of the index to search")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "namespace to search")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
 	cmd.Flags().IntVarP(&options.topK, "top-k", "k", 0, "number of results to return")
 	cmd.Flags().Var(&options.inputs, "inputs", "query inputs for search (inline JSON, ./path.json, or '-' for stdin); requires integrated embedding")
 	cmd.Flags().Var(&options.filter, "filter", "metadata filter (inline JSON, ./path.json, or '-' for stdin)")
@@ -117,6 +119,7 @@ func NewSearchCmd() *cobra.Command {
 	cmd.Flags().Var(&options.fields, "fields", "fields to return in results (inline JSON string array, ./path.json, or '-' for stdin)")
 	cmd.Flags().StringVar(&options.body, "body", "", "request body JSON (inline, ./path.json, or '-' for stdin; only one argument may use stdin)")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	_ = cmd.MarkFlagRequired("index-name")
 	cmd.MarkFlagsMutuallyExclusive("inputs", "id", "vector")
@@ -242,11 +245,9 @@ func runSearchCmd(ctx context.Context, ic RecordService, options searchCmdOption
 		return fmt.Errorf("failed to search records: %w", err)
 	}
 
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
+	presenters.PrintOutput(options.output.WithJSON(options.json), resp, func() {
 		presenters.PrintSearchRecordsTable(resp)
-	}
+	})
 
 	return nil
 }
@@ -8,6 +8,8 @@ import (
 	"io"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/argio"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
@@ -76,6 +78,7 @@ func NewUpsertCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of index to upsert into")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "namespace to upsert into")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
 	cmd.Flags().StringVar(&options.file, "body", "", "request body JSON or JSONL (inline, ./path.json[l], or '-' for stdin; only one argument may use stdin)")
 	cmd.Flags().StringVar(&options.file, "file", "", "alias for --body")
 	_ = cmd.Flags().MarkHidden("file")
@@ -122,7 +125,11 @@ func runUpsertCmd(ctx context.Context, ic RecordService, options upsertCmdOption
 
 	for i, batch := range batches {
 		if err := ic.UpsertRecords(ctx, batch); err != nil {
-			return fmt.Errorf("failed to upsert %d records in batch %d: %w", len(batch), i+1, err)
+			err = fmt.Errorf("failed to upsert %d records in batch %d: %w", len(batch), i+1, err)
+			if i > 0 {
+				err = clierr.Partial(err)
+			}
+			return err
 		}
 		if options.json {
 			summary := map[string]any{
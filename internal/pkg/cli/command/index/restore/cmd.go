@@ -69,6 +69,7 @@ func NewRestoreCmd() *cobra.Command {
 				msg.FailJSON(options.json, "Failed to create restore job: %s\n", err)
 				exit.Error(err, "Failed to create restore job")
 			}
+			sdk.InvalidateIndex(options.name)
 		},
 	}
 
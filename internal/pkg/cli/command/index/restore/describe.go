@@ -10,13 +10,16 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
 
 type describeRestoreJobCmdOptions struct {
 	restoreJobId string
 	json         bool
+	output       presenters.OutputFormat
+	watch        watch.Options
 }
 
 func NewDescribeRestoreJobCmd() *cobra.Command {
@@ -27,6 +30,9 @@ func NewDescribeRestoreJobCmd() *cobra.Command {
 		Short: "Describe a restore job by ID",
 		Example: help.Examples(`
 			pc index restore describe --id rj-123
+
+			# Follow a restore job until it finishes
+			pc index restore describe --id rj-123 --watch
 		`),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
@@ -34,7 +40,7 @@ func NewDescribeRestoreJobCmd() *cobra.Command {
 
 			err := runDescribeRestoreJobCmd(ctx, pc, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe restore job: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe restore job: %s\n", err)
 				exit.Error(err, "Failed to describe restore job")
 			}
 		},
@@ -42,6 +48,8 @@ func NewDescribeRestoreJobCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.restoreJobId, "id", "i", "", "ID of the restore job to describe")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 	_ = cmd.MarkFlagRequired("id")
 
 	return cmd
@@ -52,16 +60,33 @@ func runDescribeRestoreJobCmd(ctx context.Context, svc RestoreJobService, option
 		return fmt.Errorf("--id is required")
 	}
 
-	resp, err := svc.DescribeRestoreJob(ctx, options.restoreJobId)
-	if err != nil {
-		return err
-	}
+	format := options.output.WithJSON(options.json)
+	options.watch.Table = format.IsTable()
+	return watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+		resp, err := svc.DescribeRestoreJob(ctx, options.restoreJobId)
+		if err != nil {
+			return watch.Frame{}, err
+		}
+		return watch.Frame{
+			Print: func() {
+				presenters.PrintOutput(format, resp, func() {
+					presenters.PrintRestoreJob(resp)
+				})
+			},
+			Values: restoreJobValues(resp),
+			Done:   resp == nil || watch.IsFinal(resp.Status),
+		}, nil
+	})
+}
 
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
-		presenters.PrintRestoreJob(resp)
+// restoreJobValues tracks the progress of a restore job.
+func restoreJobValues(job *pinecone.RestoreJob) []watch.Value {
+	if job == nil {
+		return nil
 	}
-
-	return nil
+	values := []watch.Value{{Key: job.RestoreJobId + " status", Value: job.Status}}
+	if job.PercentComplete != nil {
+		values = append(values, watch.Value{Key: job.RestoreJobId + " percent complete", Value: fmt.Sprintf("%.1f%%", *job.PercentComplete)})
+	}
+	return values
 }
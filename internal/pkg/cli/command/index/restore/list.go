@@ -2,14 +2,13 @@ package restore
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -18,6 +17,9 @@ type listRestoreJobsCmdOptions struct {
 	limit           int
 	paginationToken string
 	json            bool
+	output          presenters.OutputFormat
+	list            presenters.ListOptions
+	watch           watch.Options
 }
 
 func NewListRestoreJobsCmd() *cobra.Command {
@@ -29,6 +31,9 @@ func NewListRestoreJobsCmd() *cobra.Command {
 		Example: help.Examples(`
 			pc index restore list
 			pc index restore list --limit 5 --pagination-token token
+
+			# Follow restore jobs until they all finish
+			pc index restore list --watch
 		`),
 		Run: func(cmd *cobra.Command, args []string) {
 			ctx := cmd.Context()
@@ -36,7 +41,7 @@ func NewListRestoreJobsCmd() *cobra.Command {
 
 			err := runListRestoreJobsCmd(ctx, pc, options)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list restore jobs: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list restore jobs: %s\n", err)
 				exit.Error(err, "Failed to list restore jobs")
 			}
 		},
@@ -45,6 +50,11 @@ func NewListRestoreJobsCmd() *cobra.Command {
 	cmd.Flags().IntVarP(&options.limit, "limit", "l", 0, "Maximum number of restore jobs to return")
 	cmd.Flags().StringVarP(&options.paginationToken, "pagination-token", "p", "", "Pagination token to continue a previous listing operation")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
+	watch.AddFlags(cmd, &options.watch)
 
 	return cmd
 }
@@ -60,19 +70,30 @@ func runListRestoreJobsCmd(ctx context.Context, svc RestoreJobService, options l
 		paginationToken = &options.paginationToken
 	}
 
-	resp, err := svc.ListRestoreJobs(ctx, &pinecone.ListRestoreJobsParams{
-		Limit:           limit,
-		PaginationToken: paginationToken,
+	format := options.output.WithJSON(options.json)
+	options.watch.Table = format.IsTable()
+	return watch.Run(ctx, options.watch, func(ctx context.Context) (watch.Frame, error) {
+		resp, err := svc.ListRestoreJobs(ctx, &pinecone.ListRestoreJobsParams{
+			Limit:           limit,
+			PaginationToken: paginationToken,
+		})
+		if err != nil {
+			return watch.Frame{}, err
+		}
+		frame := watch.Frame{
+			Print: func() {
+				presenters.PrintList(format, options.list, resp, presenters.RestoreJobListTable(resp), func(t presenters.Table) {
+					presenters.PrintRestoreJobList(resp, t)
+				})
+			},
+			Done: true,
+		}
+		if resp != nil {
+			for _, job := range resp.Data {
+				frame.Values = append(frame.Values, restoreJobValues(job)...)
+				frame.Done = frame.Done && (job == nil || watch.IsFinal(job.Status))
+			}
+		}
+		return frame, nil
 	})
-	if err != nil {
-		return err
-	}
-
-	if options.json {
-		fmt.Println(text.IndentJSON(resp))
-	} else {
-		presenters.PrintRestoreJobList(resp)
-	}
-
-	return nil
 }
@@ -2,10 +2,9 @@ package vector
 
 import (
 	"context"
-	"fmt"
-	"os"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/argio"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/flags"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
@@ -13,7 +12,6 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -37,6 +35,7 @@ type fetchCmdOptions struct {
 	paginationToken string
 	body            string
 	json            bool
+	output          presenters.OutputFormat
 }
 
 func NewFetchCmd() *cobra.Command {
@@ -70,10 +69,12 @@ func NewFetchCmd() *cobra.Command {
 	cmd.Flags().VarP(&options.filter, "filter", "f", "metadata filter to apply to the fetch (inline JSON, ./path.json, or '-' for stdin)")
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of the index to fetch from")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "namespace to fetch from")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
 	cmd.Flags().Uint32VarP(&options.limit, "limit", "l", 0, "maximum number of vectors to fetch")
 	cmd.Flags().StringVarP(&options.paginationToken, "pagination-token", "p", "", "pagination token to continue a previous listing operation")
 	cmd.Flags().StringVar(&options.body, "body", "", "request body JSON (inline, ./path.json, or '-' for stdin; only one argument may use stdin)")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	cmd.MarkFlagsMutuallyExclusive("ids", "filter")
 	_ = cmd.MarkFlagRequired("index-name")
@@ -87,7 +88,7 @@ func runFetchCmd(ctx context.Context, options fetchCmdOptions) {
 	// Apply body overlay if provided
 	if options.body != "" {
 		if b, src, err := argio.DecodeJSONArg[FetchBody](options.body); err != nil {
-			msg.FailJSON(options.json, "Failed to parse fetch body (%s): %s", style.Emphasis(src.Label), err)
+			msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to parse fetch body (%s): %s", style.Emphasis(src.Label), err)
 			exit.Errorf(err, "Failed to parse fetch body (%s): %v", src.Label, err)
 		} else if b != nil {
 			if len(options.ids) == 0 && len(b.Ids) > 0 {
@@ -106,18 +107,18 @@ func runFetchCmd(ctx context.Context, options fetchCmdOptions) {
 	}
 
 	if len(options.ids) > 0 && (options.limit > 0 || options.paginationToken != "") {
-		msg.FailJSON(options.json, "ids and limit/pagination-token cannot be used together")
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "ids and limit/pagination-token cannot be used together")
 		exit.ErrorMsg("ids and limit/pagination-token cannot be used together")
 	}
 
 	ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, options.namespace)
 	if err != nil {
-		msg.FailJSON(options.json, "Failed to create index connection: %s", err)
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create index connection: %s", err)
 		exit.Error(err, "Failed to create index connection")
 	}
 
 	if options.ids == nil && options.filter == nil {
-		msg.FailJSON(options.json, "Either --ids or --filter must be provided")
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Either --ids or --filter must be provided")
 		exit.ErrorMsg("Either --ids or --filter must be provided")
 	}
 
@@ -134,7 +135,7 @@ func runFetchCmd(ctx context.Context, options fetchCmdOptions) {
 	if options.filter != nil {
 		filter, err := pinecone.NewMetadataFilter(options.filter)
 		if err != nil {
-			msg.FailJSON(options.json, "Failed to create filter: %s", err)
+			msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create filter: %s", err)
 			exit.Errorf(err, "Failed to create filter")
 		}
 
@@ -158,10 +159,7 @@ func runFetchCmd(ctx context.Context, options fetchCmdOptions) {
 }
 
 func printFetchVectorsResults(results *presenters.FetchVectorsResults, options fetchCmdOptions) {
-	if options.json {
-		json := text.IndentJSON(results)
-		fmt.Fprintln(os.Stdout, json)
-	} else {
+	presenters.PrintOutput(options.output.WithJSON(options.json), results, func() {
 		presenters.PrintFetchVectorsTable(results)
-	}
+	})
 }
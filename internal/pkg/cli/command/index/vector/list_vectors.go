@@ -2,15 +2,13 @@ package vector
 
 import (
 	"context"
-	"fmt"
-	"os"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -21,6 +19,7 @@ type listVectorsCmdOptions struct {
 	limit           uint32
 	paginationToken string
 	json            bool
+	output          presenters.OutputFormat
 }
 
 func NewListVectorsCmd() *cobra.Command {
@@ -38,9 +37,11 @@ func NewListVectorsCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of the index to list vectors from")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "namespace to list vectors from")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
 	cmd.Flags().Uint32VarP(&options.limit, "limit", "l", 0, "maximum number of vectors to list")
 	cmd.Flags().StringVarP(&options.paginationToken, "pagination-token", "p", "", "pagination token to continue a previous listing operation")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	_ = cmd.MarkFlagRequired("index-name")
 
@@ -53,7 +54,7 @@ func runListVectorsCmd(ctx context.Context, options listVectorsCmdOptions) {
 	// Get IndexConnection
 	ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, options.namespace)
 	if err != nil {
-		msg.FailJSON(options.json, "Failed to create index connection: %s", err)
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create index connection: %s", err)
 		exit.Error(err, "Failed to create index connection")
 	}
 
@@ -71,14 +72,11 @@ func runListVectorsCmd(ctx context.Context, options listVectorsCmdOptions) {
 		PaginationToken: paginationToken,
 	})
 	if err != nil {
-		msg.FailJSON(options.json, "Failed to list vectors: %s", err)
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list vectors: %s", err)
 		exit.Error(err, "Failed to list vectors")
 	}
 
-	if options.json {
-		json := text.IndentJSON(resp)
-		fmt.Fprintln(os.Stdout, json)
-	} else {
+	presenters.PrintOutput(options.output.WithJSON(options.json), resp, func() {
 		presenters.PrintListVectorsTable(resp)
-	}
+	})
 }
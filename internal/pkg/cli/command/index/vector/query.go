@@ -2,10 +2,9 @@ package vector
 
 import (
 	"context"
-	"fmt"
-	"os"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/argio"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/flags"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
@@ -13,7 +12,6 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
@@ -44,6 +42,7 @@ type queryCmdOptions struct {
 	includeMetadata bool
 	body            string
 	json            bool
+	output          presenters.OutputFormat
 }
 
 func NewQueryCmd() *cobra.Command {
@@ -83,6 +82,7 @@ func NewQueryCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of the index to query")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "index namespace to query")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
 	cmd.Flags().Uint32VarP(&options.topK, "top-k", "k", 10, "maximum number of results to return")
 	cmd.Flags().VarP(&options.filter, "filter", "f", "metadata filter to apply to the query (inline JSON, ./path.json, or '-' for stdin)")
 	cmd.Flags().BoolVar(&options.includeValues, "include-values", false, "include vector values in the query results")
@@ -93,6 +93,7 @@ func NewQueryCmd() *cobra.Command {
 	cmd.Flags().Var(&options.sparseValues, "sparse-values", "sparse values to query against (inline JSON array, ./path.json, or '-' for stdin)")
 	cmd.Flags().StringVar(&options.body, "body", "", "request body JSON (inline, ./path.json, or '-' for stdin; only one argument may use stdin)")
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	_ = cmd.MarkFlagRequired("index-name")
 	cmd.MarkFlagsMutuallyExclusive("id", "vector", "sparse-values")
@@ -106,7 +107,7 @@ func runQueryCmd(ctx context.Context, options queryCmdOptions) {
 	// Apply body overlay if provided
 	if options.body != "" {
 		if b, src, err := argio.DecodeJSONArg[QueryBody](options.body); err != nil {
-			msg.FailJSON(options.json, "Failed to parse query body (%s): %s", style.Emphasis(src.Label), err)
+			msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to parse query body (%s): %s", style.Emphasis(src.Label), err)
 			exit.Errorf(err, "Failed to parse query body (%s): %v", src.Label, err)
 		} else if b != nil {
 			if options.id == "" && b.Id != "" {
@@ -135,14 +136,14 @@ func runQueryCmd(ctx context.Context, options queryCmdOptions) {
 	}
 
 	if options.id == "" && options.vector == nil && options.sparseIndices == nil && options.sparseValues == nil {
-		msg.FailJSON(options.json, "One of --id, --vector, or --sparse-indices & --sparse-values must be provided")
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "One of --id, --vector, or --sparse-indices & --sparse-values must be provided")
 		exit.ErrorMsg("One of --id, --vector, or --sparse-indices & --sparse-values must be provided")
 	}
 
 	// Get IndexConnection
 	ic, err := sdk.NewIndexConnection(ctx, pc, options.indexName, options.namespace)
 	if err != nil {
-		msg.FailJSON(options.json, "Failed to create index connection: %s", err)
+		msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create index connection: %s", err)
 		exit.Error(err, "Failed to create index connection")
 	}
 
@@ -153,7 +154,7 @@ func runQueryCmd(ctx context.Context, options queryCmdOptions) {
 	if options.filter != nil {
 		filter, err = pinecone.NewMetadataFilter(options.filter)
 		if err != nil {
-			msg.FailJSON(options.json, "Failed to create filter: %s", err)
+			msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to create filter: %s", err)
 			exit.Errorf(err, "Failed to create filter")
 		}
 	}
@@ -207,10 +208,7 @@ func runQueryCmd(ctx context.Context, options queryCmdOptions) {
 		}
 	}
 
-	if options.json {
-		json := text.IndentJSON(queryResponse)
-		fmt.Fprintln(os.Stdout, json)
-	} else {
+	presenters.PrintOutput(options.output.WithJSON(options.json), queryResponse, func() {
 		presenters.PrintQueryVectorsTable(queryResponse)
-	}
+	})
 }
@@ -0,0 +1,107 @@
+package vector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// DefaultTopK is used by Query when the body doesn't set top_k.
+const DefaultTopK = 10
+
+// Query runs a query body against ic. It is used by long-running commands,
+// such as pc serve and pc mcp serve, that take bodies rather than flags.
+func Query(ctx context.Context, ic *pinecone.IndexConnection, body QueryBody) (*pinecone.QueryVectorsResponse, error) {
+	hasSparse := body.SparseValues != nil && len(body.SparseValues.Indices) > 0
+	if body.Id == "" && len(body.Vector) == 0 && !hasSparse {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "one of id, vector or sparse_values is required")
+	}
+	if body.Id != "" && (len(body.Vector) > 0 || hasSparse) {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "id can't be combined with vector or sparse_values")
+	}
+	if hasSparse && len(body.SparseValues.Indices) != len(body.SparseValues.Values) {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "sparse_values.indices and sparse_values.values must be the same length")
+	}
+
+	topK := uint32(DefaultTopK)
+	if body.TopK != nil {
+		topK = *body.TopK
+	}
+	var filter *pinecone.MetadataFilter
+	if body.Filter != nil {
+		var err error
+		if filter, err = pinecone.NewMetadataFilter(body.Filter); err != nil {
+			return nil, clierr.New(clierr.CodeInvalidArgument, "invalid filter: %s", err)
+		}
+	}
+	includeValues := body.IncludeValues != nil && *body.IncludeValues
+	includeMetadata := body.IncludeMetadata != nil && *body.IncludeMetadata
+
+	if body.Id != "" {
+		return ic.QueryByVectorId(ctx, &pinecone.QueryByVectorIdRequest{
+			VectorId:        body.Id,
+			TopK:            topK,
+			MetadataFilter:  filter,
+			IncludeValues:   includeValues,
+			IncludeMetadata: includeMetadata,
+		})
+	}
+	var sparse *pinecone.SparseValues
+	if hasSparse {
+		sparse = body.SparseValues
+	}
+	return ic.QueryByVectorValues(ctx, &pinecone.QueryByVectorValuesRequest{
+		Vector:          body.Vector,
+		SparseValues:    sparse,
+		TopK:            topK,
+		MetadataFilter:  filter,
+		IncludeValues:   includeValues,
+		IncludeMetadata: includeMetadata,
+	})
+}
+
+// Fetch runs a fetch body against ic, by ids or else by metadata filter.
+func Fetch(ctx context.Context, ic *pinecone.IndexConnection, body FetchBody) (any, error) {
+	if len(body.Ids) > 0 {
+		if body.Filter != nil {
+			return nil, clierr.New(clierr.CodeInvalidArgument, "ids can't be combined with filter")
+		}
+		return ic.FetchVectors(ctx, body.Ids)
+	}
+	if body.Filter == nil {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "one of ids or filter is required")
+	}
+	filter, err := pinecone.NewMetadataFilter(body.Filter)
+	if err != nil {
+		return nil, clierr.New(clierr.CodeInvalidArgument, "invalid filter: %s", err)
+	}
+	return ic.FetchVectorsByMetadata(ctx, &pinecone.FetchVectorsByMetadataRequest{
+		Filter:          filter,
+		Limit:           body.Limit,
+		PaginationToken: body.PaginationToken,
+	})
+}
+
+// UpsertInBatches upserts vectors batchSize at a time and returns how many
+// were upserted. On failure the count covers the batches that succeeded.
+func UpsertInBatches(ctx context.Context, ic *pinecone.IndexConnection, vectors []pinecone.Vector, batchSize int) (uint32, error) {
+	if len(vectors) == 0 {
+		return 0, clierr.New(clierr.CodeInvalidArgument, "no vectors provided")
+	}
+	ptrs := make([]*pinecone.Vector, len(vectors))
+	for i := range vectors {
+		ptrs[i] = &vectors[i]
+	}
+	var upserted uint32
+	for start := 0; start < len(ptrs); start += batchSize {
+		end := min(start+batchSize, len(ptrs))
+		n, err := ic.UpsertVectors(ctx, ptrs[start:end])
+		if err != nil {
+			return upserted, fmt.Errorf("upserted %d of %d vectors: %w", upserted, len(ptrs), err)
+		}
+		upserted += n
+	}
+	return upserted, nil
+}
@@ -9,6 +9,8 @@ import (
 	"os"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/argio"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
@@ -62,6 +64,7 @@ func NewUpsertCmd() *cobra.Command {
 
 	cmd.Flags().StringVarP(&options.indexName, "index-name", "i", "", "name of index to upsert into")
 	cmd.Flags().StringVar(&options.namespace, "namespace", "", "namespace to upsert into")
+	projectfile.AllowFlagDefaults(cmd.Flags(), "index-name", "namespace")
 	cmd.Flags().StringVar(&options.file, "body", "", "request body JSON or JSONL (inline, ./path.json[l], or '-' for stdin; only one argument may use stdin)")
 	cmd.Flags().StringVar(&options.file, "file", "", "alias for --body")
 	_ = cmd.Flags().MarkHidden("file")
@@ -133,6 +136,9 @@ func runUpsertCmd(ctx context.Context, options upsertCmdOptions) {
 	for i, batch := range batches {
 		resp, err := ic.UpsertVectors(ctx, batch)
 		if err != nil {
+			if i > 0 {
+				err = clierr.Partial(err)
+			}
 			msg.FailJSON(options.json, "Failed to upsert %d vectors in batch %d: %s", len(batch), i+1, err)
 			exit.Errorf(err, "Failed to upsert %d vectors in batch %d", len(batch), i+1)
 		} else {
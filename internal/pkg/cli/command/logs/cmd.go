@@ -0,0 +1,34 @@
+package logs
+
+import (
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logsHelp = help.LongF(`
+		Read the Pinecone CLI log file.
+
+		When the log-level config key is set, the CLI writes a JSON-lines log of
+		its activity, including the detached login process, to pc.log in the %s
+		directory. The file is rotated when it reaches 5 MiB.
+
+		To start logging, run 'pc config set log-level info' (or debug for more
+		detail). Attach the output of 'pc logs show --json' to a support ticket
+		when something goes wrong.
+	`, configuration.LogsDirPath())
+)
+
+func NewLogsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "Read the Pinecone CLI log file",
+		Long:  logsHelp,
+	}
+
+	cmd.AddCommand(NewShowCmd())
+	cmd.AddCommand(NewTailCmd())
+
+	return cmd
+}
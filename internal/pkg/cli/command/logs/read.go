@@ -0,0 +1,102 @@
+package logs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	conf "github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/rs/zerolog"
+	"golang.org/x/term"
+)
+
+// lastLines returns up to n of the most recent lines across files, which are
+// ordered oldest first. n <= 0 returns every line.
+func lastLines(files []string, n int) ([]string, error) {
+	var lines []string
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Rotated away by another process since it was listed.
+				continue
+			}
+			return nil, err
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			lines = append(lines, scanner.Text())
+			if n > 0 && len(lines) > 2*n {
+				lines = append(lines[:0], lines[len(lines)-n:]...)
+			}
+		}
+		err = scanner.Err()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+	}
+	if n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return lines, nil
+}
+
+// lineWriter prints log lines, either as stored or formatted for reading.
+type lineWriter struct {
+	out     io.Writer
+	raw     bool
+	console zerolog.ConsoleWriter
+}
+
+func newLineWriter(out io.Writer, raw bool) *lineWriter {
+	noColor := !conf.Color.Get() || !term.IsTerminal(int(os.Stdout.Fd()))
+	return &lineWriter{
+		out: out,
+		raw: raw,
+		console: zerolog.ConsoleWriter{
+			Out:        out,
+			NoColor:    noColor,
+			TimeFormat: time.DateTime,
+		},
+	}
+}
+
+func (w *lineWriter) print(line string) {
+	if w.raw {
+		fmt.Fprintln(w.out, line)
+		return
+	}
+	// Lines that aren't log entries, e.g. cut short by a crash, are printed as is.
+	if _, err := w.console.Write([]byte(line)); err != nil {
+		fmt.Fprintln(w.out, line)
+	}
+}
+
+// printNoLogFile explains how to enable the log file when there is none.
+func printNoLogFile() {
+	msg.InfoMsg("No log file found in %s", configuration.LogsDirPath())
+	if level, _ := log.ParseLevel(conf.LogLevel.Get()); level == zerolog.Disabled {
+		msg.HintMsg("To start logging, run %s", style.Code("pc config set log-level info"))
+	}
+}
+
+// splitLines returns the complete lines in buf and the trailing partial line.
+func splitLines(buf []byte) (lines []string, rest []byte) {
+	for {
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			return lines, buf
+		}
+		lines = append(lines, string(buf[:i]))
+		buf = buf[i+1:]
+	}
+}
@@ -0,0 +1,71 @@
+package logs
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLines(t *testing.T, path string, from, to int) {
+	t.Helper()
+	var b strings.Builder
+	for i := from; i <= to; i++ {
+		fmt.Fprintf(&b, "line %d\n", i)
+	}
+	require.NoError(t, os.WriteFile(path, []byte(b.String()), 0o600))
+}
+
+func TestLastLines_AcrossRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	older := filepath.Join(dir, "pc.log.1")
+	current := filepath.Join(dir, "pc.log")
+	writeLines(t, older, 1, 10)
+	writeLines(t, current, 11, 13)
+
+	lines, err := lastLines([]string{older, current}, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line 9", "line 10", "line 11", "line 12", "line 13"}, lines)
+
+	all, err := lastLines([]string{older, current}, 0)
+	require.NoError(t, err)
+	assert.Len(t, all, 13)
+}
+
+func TestLastLines_SkipsMissingFiles(t *testing.T) {
+	dir := t.TempDir()
+	current := filepath.Join(dir, "pc.log")
+	writeLines(t, current, 1, 2)
+
+	lines, err := lastLines([]string{filepath.Join(dir, "pc.log.1"), current}, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"line 1", "line 2"}, lines)
+}
+
+func TestSplitLines(t *testing.T) {
+	lines, rest := splitLines([]byte("a\nb\npart"))
+	assert.Equal(t, []string{"a", "b"}, lines)
+	assert.Equal(t, "part", string(rest))
+}
+
+func TestLineWriter(t *testing.T) {
+	entry := `{"level":"info","pid":1,"time":"2026-01-02T03:04:05Z","message":"running command"}`
+
+	var raw bytes.Buffer
+	newLineWriter(&raw, true).print(entry)
+	assert.Equal(t, entry+"\n", raw.String())
+
+	var human bytes.Buffer
+	w := newLineWriter(&human, false)
+	w.console.NoColor = true
+	w.print(entry)
+	w.print("not json")
+	assert.Contains(t, human.String(), "INF")
+	assert.Contains(t, human.String(), "running command")
+	assert.Contains(t, human.String(), "not json")
+}
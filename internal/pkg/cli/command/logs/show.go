@@ -0,0 +1,57 @@
+package logs
+
+import (
+	"os"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/spf13/cobra"
+)
+
+type ShowCmdOptions struct {
+	last int
+	json bool
+}
+
+func NewShowCmd() *cobra.Command {
+	options := ShowCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Print the most recent log entries",
+		Long: help.Long(`
+			Print the most recent entries of the CLI log file, including rotated
+			files. Use --json to print the entries as stored, one JSON object per line.
+		`),
+		Example: help.Examples(`
+			pc logs show
+			pc logs show --last 500
+			pc logs show --last 0 --json > pc-log.jsonl
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			files := log.Files(configuration.LogsDirPath())
+			if len(files) == 0 {
+				printNoLogFile()
+				return
+			}
+			lines, err := lastLines(files, options.last)
+			if err != nil {
+				msg.FailMsg("Failed to read the log file: %s", err)
+				exit.Error(err, "Failed to read the log file")
+			}
+			w := newLineWriter(os.Stdout, options.json)
+			for _, line := range lines {
+				w.print(line)
+			}
+		},
+	}
+
+	cmd.Flags().IntVarP(&options.last, "last", "n", 100, "Number of entries to print, 0 for all")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Print entries as JSON lines")
+
+	return cmd
+}
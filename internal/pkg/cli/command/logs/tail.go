@@ -0,0 +1,134 @@
+package logs
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/spf13/cobra"
+)
+
+const tailPollInterval = 500 * time.Millisecond
+
+type TailCmdOptions struct {
+	last int
+	json bool
+}
+
+func NewTailCmd() *cobra.Command {
+	options := TailCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tail",
+		Short: "Print the last log entries and follow new ones",
+		Long: help.Long(`
+			Print the last entries of the CLI log file, then keep printing entries
+			as other pc commands write them, until interrupted with Ctrl-C.
+		`),
+		Example: help.Examples(`
+			pc logs tail
+			pc logs tail --last 50 --json
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			dir := configuration.LogsDirPath()
+			files := log.Files(dir)
+			if len(files) == 0 {
+				printNoLogFile()
+				return
+			}
+			lines, err := lastLines(files, options.last)
+			if err != nil {
+				msg.FailMsg("Failed to read the log file: %s", err)
+				exit.Error(err, "Failed to read the log file")
+			}
+			w := newLineWriter(os.Stdout, options.json)
+			for _, line := range lines {
+				w.print(line)
+			}
+
+			// Follow until interrupted; the global --timeout doesn't apply.
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+			if err := follow(ctx, filepath.Join(dir, log.FileName), w); err != nil {
+				msg.FailMsg("Failed to follow the log file: %s", err)
+				exit.Error(err, "Failed to follow the log file")
+			}
+		},
+	}
+
+	cmd.Flags().IntVarP(&options.last, "last", "n", 10, "Number of entries to print before following")
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Print entries as JSON lines")
+
+	return cmd
+}
+
+// follow prints lines appended to path from its current end until ctx is done.
+// When the file is rotated it starts over at the beginning of the new file.
+func follow(ctx context.Context, path string, w *lineWriter) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { f.Close() }()
+	offset, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+
+	var partial []byte
+	buf := make([]byte, 32*1024)
+	ticker := time.NewTicker(tailPollInterval)
+	defer ticker.Stop()
+	for {
+		for {
+			n, err := f.Read(buf)
+			if n > 0 {
+				offset += int64(n)
+				var lines []string
+				lines, partial = splitLines(append(partial, buf[:n]...))
+				for _, line := range lines {
+					w.print(line)
+				}
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// Reopen when the file was rotated: a different file now has the name,
+		// or it is shorter than what was already read.
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		current, err := f.Stat()
+		if err == nil && os.SameFile(info, current) && info.Size() >= offset {
+			continue
+		}
+		next, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		f.Close()
+		f, offset, partial = next, 0, nil
+	}
+}
@@ -0,0 +1,26 @@
+package mcp
+
+import (
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/spf13/cobra"
+)
+
+var (
+	mcpHelp = help.Long(`
+		Run the Pinecone CLI as a Model Context Protocol (MCP) server, so that AI
+		coding agents can work with your indexes using the same credentials and
+		target project as the CLI.
+	`)
+)
+
+func NewMCPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Run the CLI as an MCP server for AI agents",
+		Long:  mcpHelp,
+	}
+
+	cmd.AddCommand(NewServeCmd())
+
+	return cmd
+}
@@ -0,0 +1,104 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/build"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/mcp"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/spf13/cobra"
+)
+
+const serverInstructions = "Tools operate on the Pinecone project targeted by the pc CLI (see pc target). " +
+	"Call list_indexes first to find index names. query_vectors and search_records take the same fields as the CLI's --body payloads."
+
+type ServeCmdOptions struct {
+	allowTools []string
+}
+
+func NewServeCmd() *cobra.Command {
+	options := ServeCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve CLI capabilities as MCP tools over stdio",
+		Long: help.LongF(`
+			Start an MCP server that reads JSON-RPC requests from stdin and writes
+			responses to stdout. Configure it as a stdio server in your agent, with
+			'pc' as the command and 'mcp serve' as the arguments.
+
+			Read-only tools are always available: list_indexes, describe_index,
+			describe_index_stats, query_vectors, search_records, list_backups and
+			describe_backup. Tools that write data are only served when named with
+			--allow-tool: %s.
+
+			Tool inputs use the same JSON fields as the corresponding --body
+			payloads. Each tool call is subject to --timeout; the server itself
+			runs until stdin is closed.
+		`, strings.Join(writeToolNames(), ", ")),
+		Example: help.Examples(`
+			pc mcp serve
+			pc mcp serve --allow-tool upsert_vectors --allow-tool upsert_records
+			claude mcp add pinecone -- pc mcp serve
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+			if err := runServeCmd(cmd.Context(), options, timeout); err != nil {
+				msg.FailMsg("MCP server failed: %s", err)
+				exit.Error(err, "MCP server failed")
+			}
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&options.allowTools, "allow-tool", nil, "serve a tool that writes data (repeatable): "+strings.Join(writeToolNames(), ", "))
+
+	return cmd
+}
+
+func runServeCmd(ctx context.Context, options ServeCmdOptions, timeout time.Duration) error {
+	// Resolve credentials up front so that auth problems show at startup
+	// rather than on the first tool call.
+	s := newSession(sdk.NewPineconeClient(ctx))
+	defer s.conns.Close()
+
+	tools, err := selectTools(allTools(s), options.allowTools)
+	if err != nil {
+		return clierr.Wrap(clierr.CodeUsage, err)
+	}
+	for i := range tools {
+		tools[i].Handler = withTimeout(tools[i].Handler, timeout)
+	}
+
+	server := mcp.NewServer("pinecone-cli", build.Version, tools)
+	server.Instructions = serverInstructions
+
+	// Serve until stdin is closed or the process is interrupted; the global
+	// --timeout applies to each tool call instead.
+	serveCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	log.Info().Int("tools", len(tools)).Msg("MCP server started")
+	return server.Serve(serveCtx, os.Stdin, os.Stdout)
+}
+
+func withTimeout(h mcp.Handler, timeout time.Duration) mcp.Handler {
+	if timeout <= 0 {
+		return h
+	}
+	return func(ctx context.Context, args json.RawMessage) (any, error) {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return h(ctx, args)
+	}
+}
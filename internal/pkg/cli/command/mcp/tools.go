@@ -0,0 +1,331 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/index/record"
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/index/vector"
+	"github.com/pinecone-io/cli/internal/pkg/utils/mcp"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+const (
+	vectorBatchSize   = 500
+	recordBatchSize   = 96
+	defaultListBackup = 100
+)
+
+type indexArgs struct {
+	IndexName string `json:"index_name" jsonschema:"required" description:"Name of the index"`
+	Namespace string `json:"namespace,omitempty" description:"Namespace to use; omit for the default namespace"`
+}
+
+type describeIndexArgs struct {
+	Name string `json:"name" jsonschema:"required" description:"Name of the index"`
+}
+
+type indexStatsArgs struct {
+	IndexName string         `json:"index_name" jsonschema:"required" description:"Name of the index"`
+	Filter    map[string]any `json:"filter,omitempty" description:"Metadata filter; only vectors that match are counted"`
+}
+
+type queryArgs struct {
+	indexArgs
+	vector.QueryBody
+}
+
+type searchArgs struct {
+	indexArgs
+	pinecone.SearchRecordsRequest
+}
+
+type upsertVectorsArgs struct {
+	indexArgs
+	vector.UpsertBody
+}
+
+type upsertRecordsArgs struct {
+	indexArgs
+	record.UpsertRecordsBody
+}
+
+type listBackupsArgs struct {
+	pinecone.ListBackupsParams
+}
+
+type describeBackupArgs struct {
+	BackupId string `json:"backup_id" jsonschema:"required" description:"ID of the backup"`
+}
+
+type createBackupArgs struct {
+	pinecone.CreateBackupParams
+}
+
+// session holds the client and index connections shared by tool calls.
+type session struct {
+	pc    *pinecone.Client
+	conns *sdk.IndexConnectionCache
+}
+
+func newSession(pc *pinecone.Client) *session {
+	return &session{pc: pc, conns: sdk.NewIndexConnectionCache(pc)}
+}
+
+func (s *session) index(ctx context.Context, indexName, namespace string) (*pinecone.IndexConnection, error) {
+	return s.conns.Get(ctx, indexName, namespace)
+}
+
+// toolDef is a tool before the allow-list is applied. Tools that change data
+// are only served when named with --allow-tool.
+type toolDef struct {
+	mcp.Tool
+	write bool
+}
+
+func allTools(s *session) []toolDef {
+	return []toolDef{
+		{Tool: mcp.Tool{
+			Name:        "list_indexes",
+			Title:       "List indexes",
+			Description: "List the indexes in the target project, with their dimension, metric, host and status.",
+			InputSchema: mcp.SchemaFor(struct{}{}),
+			ReadOnly:    true,
+			Handler: func(ctx context.Context, _ json.RawMessage) (any, error) {
+				return s.pc.ListIndexes(ctx)
+			},
+		}},
+		{Tool: mcp.Tool{
+			Name:        "describe_index",
+			Title:       "Describe index",
+			Description: "Describe an index's configuration and status.",
+			InputSchema: mcp.SchemaFor(describeIndexArgs{}),
+			ReadOnly:    true,
+			Handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				args, err := mcp.DecodeArgs[describeIndexArgs](raw)
+				if err != nil {
+					return nil, err
+				}
+				return s.pc.DescribeIndex(ctx, args.Name)
+			},
+		}},
+		{Tool: mcp.Tool{
+			Name:        "describe_index_stats",
+			Title:       "Index and namespace stats",
+			Description: "Return the vector count of an index and of each of its namespaces, optionally counting only vectors that match a metadata filter.",
+			InputSchema: mcp.SchemaFor(indexStatsArgs{}),
+			ReadOnly:    true,
+			Handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				args, err := mcp.DecodeArgs[indexStatsArgs](raw)
+				if err != nil {
+					return nil, err
+				}
+				ic, err := s.index(ctx, args.IndexName, "")
+				if err != nil {
+					return nil, err
+				}
+				if args.Filter == nil {
+					return ic.DescribeIndexStats(ctx)
+				}
+				filter, err := pinecone.NewMetadataFilter(args.Filter)
+				if err != nil {
+					return nil, fmt.Errorf("invalid filter: %w", err)
+				}
+				return ic.DescribeIndexStatsFiltered(ctx, filter)
+			},
+		}},
+		{Tool: mcp.Tool{
+			Name:        "query_vectors",
+			Title:       "Query vectors",
+			Description: "Find the vectors most similar to a dense or sparse vector, or to the vector with a given id. Takes the same fields as pc index vector query --body; top_k defaults to 10 and metadata is included unless include_metadata is false.",
+			InputSchema: mcp.SchemaFor(queryArgs{}),
+			ReadOnly:    true,
+			Handler:     s.queryVectors,
+		}},
+		{Tool: mcp.Tool{
+			Name:        "search_records",
+			Title:       "Search records",
+			Description: "Search an index by text (integrated embedding indexes), record id or vector, with optional filter and reranking. Takes the same fields as pc index record search --body.",
+			InputSchema: mcp.SchemaFor(searchArgs{}),
+			ReadOnly:    true,
+			Handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				args, err := mcp.DecodeArgs[searchArgs](raw)
+				if err != nil {
+					return nil, err
+				}
+				if args.Query.TopK <= 0 {
+					args.Query.TopK = vector.DefaultTopK
+				}
+				ic, err := s.index(ctx, args.IndexName, args.Namespace)
+				if err != nil {
+					return nil, err
+				}
+				return ic.SearchRecords(ctx, &args.SearchRecordsRequest)
+			},
+		}},
+		{Tool: mcp.Tool{
+			Name:        "list_backups",
+			Title:       "List backups",
+			Description: "List the backups of an index, or of the whole project when index_name is omitted.",
+			InputSchema: mcp.SchemaFor(listBackupsArgs{}),
+			ReadOnly:    true,
+			Handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				args, err := mcp.DecodeArgs[listBackupsArgs](raw)
+				if err != nil {
+					return nil, err
+				}
+				if args.Limit == nil {
+					limit := defaultListBackup
+					args.Limit = &limit
+				}
+				return s.pc.ListBackups(ctx, &args.ListBackupsParams)
+			},
+		}},
+		{Tool: mcp.Tool{
+			Name:        "describe_backup",
+			Title:       "Describe backup",
+			Description: "Describe a backup by id.",
+			InputSchema: mcp.SchemaFor(describeBackupArgs{}),
+			ReadOnly:    true,
+			Handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				args, err := mcp.DecodeArgs[describeBackupArgs](raw)
+				if err != nil {
+					return nil, err
+				}
+				return s.pc.DescribeBackup(ctx, args.BackupId)
+			},
+		}},
+		{write: true, Tool: mcp.Tool{
+			Name:        "upsert_vectors",
+			Title:       "Upsert vectors",
+			Description: "Insert vectors into a namespace, overwriting vectors that have the same id. Takes the same fields as pc index vector upsert --body.",
+			InputSchema: withRequired(mcp.SchemaFor(upsertVectorsArgs{}), "vectors"),
+			Destructive: true,
+			Handler:     s.upsertVectors,
+		}},
+		{write: true, Tool: mcp.Tool{
+			Name:        "upsert_records",
+			Title:       "Upsert records",
+			Description: "Insert text records into a namespace of an integrated embedding index, overwriting records that have the same _id. Takes the same fields as pc index record upsert --body.",
+			InputSchema: withRequired(mcp.SchemaFor(upsertRecordsArgs{}), "records"),
+			Destructive: true,
+			Handler:     s.upsertRecords,
+		}},
+		{write: true, Tool: mcp.Tool{
+			Name:        "create_backup",
+			Title:       "Create backup",
+			Description: "Start a backup of a serverless index.",
+			InputSchema: withRequired(mcp.SchemaFor(createBackupArgs{}), "index_name"),
+			Handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+				args, err := mcp.DecodeArgs[createBackupArgs](raw)
+				if err != nil {
+					return nil, err
+				}
+				return s.pc.CreateBackup(ctx, &args.CreateBackupParams)
+			},
+		}},
+	}
+}
+
+// writeToolNames returns the names of the tools that need --allow-tool.
+func writeToolNames() []string {
+	var names []string
+	for _, t := range allTools(nil) {
+		if t.write {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+// selectTools returns the read-only tools plus the write tools named in
+// allowed. Naming a tool that doesn't exist, or isn't a write tool, is an
+// error so that typos don't go unnoticed.
+func selectTools(defs []toolDef, allowed []string) ([]mcp.Tool, error) {
+	for _, name := range allowed {
+		if !slices.ContainsFunc(defs, func(t toolDef) bool { return t.write && t.Name == name }) {
+			return nil, fmt.Errorf("--allow-tool %q: must be one of %s", name, strings.Join(writeToolNames(), ", "))
+		}
+	}
+
+	var tools []mcp.Tool
+	for _, t := range defs {
+		if t.write && !slices.Contains(allowed, t.Name) {
+			continue
+		}
+		tools = append(tools, t.Tool)
+	}
+	return tools, nil
+}
+
+func withRequired(s mcp.Schema, names ...string) mcp.Schema {
+	required, _ := s["required"].([]string)
+	s["required"] = append(required, names...)
+	return s
+}
+
+func (s *session) queryVectors(ctx context.Context, raw json.RawMessage) (any, error) {
+	args, err := mcp.DecodeArgs[queryArgs](raw)
+	if err != nil {
+		return nil, err
+	}
+	// Agents almost always want the metadata of the matches
+	if args.IncludeMetadata == nil {
+		include := true
+		args.IncludeMetadata = &include
+	}
+	ic, err := s.index(ctx, args.IndexName, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return vector.Query(ctx, ic, args.QueryBody)
+}
+
+func (s *session) upsertVectors(ctx context.Context, raw json.RawMessage) (any, error) {
+	args, err := mcp.DecodeArgs[upsertVectorsArgs](raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(args.Vectors) == 0 {
+		return nil, fmt.Errorf("no vectors provided")
+	}
+	ic, err := s.index(ctx, args.IndexName, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	upserted, err := vector.UpsertInBatches(ctx, ic, args.Vectors, vectorBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"upserted_count": upserted}, nil
+}
+
+func (s *session) upsertRecords(ctx context.Context, raw json.RawMessage) (any, error) {
+	args, err := mcp.DecodeArgs[upsertRecordsArgs](raw)
+	if err != nil {
+		return nil, err
+	}
+	if len(args.Records) == 0 {
+		return nil, fmt.Errorf("no records provided")
+	}
+	ic, err := s.index(ctx, args.IndexName, args.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*pinecone.IntegratedRecord, len(args.Records))
+	for i := range args.Records {
+		records[i] = &args.Records[i]
+	}
+	for start := 0; start < len(records); start += recordBatchSize {
+		end := min(start+recordBatchSize, len(records))
+		if err := ic.UpsertRecords(ctx, records[start:end]); err != nil {
+			return nil, fmt.Errorf("upserted %d of %d records: %w", start, len(records), err)
+		}
+	}
+	return map[string]any{"upserted_count": len(records)}, nil
+}
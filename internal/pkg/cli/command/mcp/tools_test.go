@@ -0,0 +1,60 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func toolNames(t *testing.T, allowed []string) []string {
+	t.Helper()
+	tools, err := selectTools(allTools(newSession(nil)), allowed)
+	require.NoError(t, err)
+	names := make([]string, len(tools))
+	for i, tool := range tools {
+		names[i] = tool.Name
+	}
+	return names
+}
+
+func TestSelectToolsServesOnlyReadOnlyToolsByDefault(t *testing.T) {
+	names := toolNames(t, nil)
+
+	assert.Contains(t, names, "query_vectors")
+	assert.Contains(t, names, "search_records")
+	for _, write := range writeToolNames() {
+		assert.NotContains(t, names, write)
+	}
+}
+
+func TestSelectToolsAllowList(t *testing.T) {
+	names := toolNames(t, []string{"upsert_vectors"})
+
+	assert.Contains(t, names, "upsert_vectors")
+	assert.NotContains(t, names, "upsert_records")
+	assert.NotContains(t, names, "create_backup")
+}
+
+func TestSelectToolsRejectsUnknownNames(t *testing.T) {
+	_, err := selectTools(allTools(newSession(nil)), []string{"delete_index"})
+	assert.ErrorContains(t, err, "delete_index")
+
+	_, err = selectTools(allTools(newSession(nil)), []string{"list_indexes"})
+	assert.Error(t, err, "read-only tools are always served")
+}
+
+func TestToolSchemas(t *testing.T) {
+	byName := map[string]map[string]any{}
+	for _, def := range allTools(newSession(nil)) {
+		assert.Equal(t, "object", def.InputSchema["type"], def.Name)
+		assert.Equal(t, def.write, !def.ReadOnly, "%s: write tools are exactly the non-read-only ones", def.Name)
+		byName[def.Name] = def.InputSchema
+	}
+
+	query := byName["query_vectors"]
+	assert.Equal(t, []string{"index_name"}, query["required"])
+	assert.Contains(t, query["properties"], "top_k", "derived from QueryBody")
+	assert.Contains(t, byName["search_records"]["properties"], "query", "derived from SearchRecordsRequest")
+	assert.Equal(t, []string{"index_name", "vectors"}, byName["upsert_vectors"]["required"])
+}
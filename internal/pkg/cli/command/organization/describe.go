@@ -1,9 +1,6 @@
 package organization
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
@@ -11,13 +8,13 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 )
 
 type describeOrganizationCmdOptions struct {
 	organizationID string
 	json           bool
+	output         presenters.OutputFormat
 }
 
 func NewDescribeOrganizationCmd() *cobra.Command {
@@ -39,23 +36,20 @@ func NewDescribeOrganizationCmd() *cobra.Command {
 			if orgId == "" {
 				orgId, err = state.GetTargetOrgId()
 				if err != nil {
-					msg.FailJSON(options.json, "No target organization set and no organization ID provided. Use %s to set the target organization. Use %s to describe an organization by ID.", style.Code("pc target -o <org>"), style.Code("pc organization describe -i <organization-id>"))
+					msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "No target organization set and no organization ID provided. Use %s to set the target organization. Use %s to describe an organization by ID.", style.Code("pc target -o <org>"), style.Code("pc organization describe -i <organization-id>"))
 					exit.ErrorMsg("No organization ID provided, and no target organization set")
 				}
 			}
 
 			org, err := ac.Organization.Describe(cmd.Context(), orgId)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe organization %s: %s\n", orgId, err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe organization %s: %s\n", orgId, err)
 				exit.Errorf(err, "Failed to describe organization %s", style.Emphasis(orgId))
 			}
 
-			if options.json {
-				json := text.IndentJSON(org)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
+			presenters.PrintOutput(options.output.WithJSON(options.json), org, func() {
 				presenters.PrintDescribeOrganizationTable(org)
-			}
+			})
 		},
 	}
 
@@ -65,6 +59,7 @@ func NewDescribeOrganizationCmd() *cobra.Command {
 
 	// optional flags
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	return cmd
 }
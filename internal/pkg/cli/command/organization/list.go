@@ -1,10 +1,6 @@
 package organization
 
 import (
-	"fmt"
-	"os"
-	"strings"
-
 	"github.com/spf13/cobra"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
@@ -12,12 +8,13 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
 
 type listOrganizationCmdOptions struct {
-	json bool
+	json   bool
+	output presenters.OutputFormat
+	list   presenters.ListOptions
 }
 
 func NewListOrganizationsCmd() *cobra.Command {
@@ -36,32 +33,30 @@ func NewListOrganizationsCmd() *cobra.Command {
 
 			orgs, err := ac.Organization.List(cmd.Context())
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list organizations: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list organizations: %s\n", err)
 				exit.Error(err, "Failed to list organizations")
 			}
 
-			if options.json {
-				json := text.IndentJSON(orgs)
-				fmt.Fprintln(os.Stdout, json)
-				return
-			}
-
-			printTable(orgs)
+			presenters.PrintList(options.output.WithJSON(options.json), options.list, orgs, organizationTable(orgs), presenters.PrintTable)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
 
 	return cmd
 }
 
-func printTable(orgs []*pinecone.Organization) {
-	writer := presenters.NewTabWriter()
-
-	columns := []string{"NAME", "ID", "CREATED AT", "PAYMENT STATUS", "PLAN", "SUPPORT TIER"}
-	header := strings.Join(columns, "\t") + "\n"
-	fmt.Fprint(writer, header)
-
+func organizationTable(orgs []*pinecone.Organization) presenters.Table {
+	table := presenters.Table{
+		Resource: "organizations",
+		Columns: []presenters.TableColumn{
+			{Header: "NAME"}, {Header: "ID"}, {Header: "CREATED AT"}, {Header: "PAYMENT STATUS"}, {Header: "PLAN"}, {Header: "SUPPORT TIER"},
+		},
+	}
 	for _, org := range orgs {
 		values := []string{
 			org.Name,
@@ -71,7 +66,8 @@ func printTable(orgs []*pinecone.Organization) {
 			org.Plan,
 			org.SupportTier,
 		}
-		fmt.Fprint(writer, strings.Join(values, "\t")+"\n")
+		table.Rows = append(table.Rows, values)
+		table.Records = append(table.Records, org)
 	}
-	writer.Flush()
+	return table
 }
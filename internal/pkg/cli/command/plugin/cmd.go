@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/plugin"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginHelp = help.LongF(`
+		Extend the CLI with plugins.
+
+		A plugin is any executable named pc-<name>, in the %s directory or on
+		your PATH. Running 'pc <name> [args]' runs it with args, and plugins are
+		listed under "Plugins" in 'pc --help'. Built-in commands and aliases take
+		precedence over plugins with the same name.
+
+		Plugins run with the target context and credentials of pc in these
+		environment variables:
+
+		  PC_ENVIRONMENT     environment name, e.g. production
+		  PC_API_URL         control plane base URL
+		  PC_ORG_ID          target organization ID, and PC_ORG_NAME
+		  PC_PROJECT_ID      target project ID, and PC_PROJECT_NAME
+		  PC_ACCESS_TOKEN    short-lived bearer token from the login or service
+		                     account; send PC_PROJECT_ID as X-Project-Id with it
+		  PC_API_KEY         the configured default API key, only when there's
+		                     no PC_ACCESS_TOKEN or plugin-api-key is on
+		  PC_BIN             path of the pc executable, for calling back into pc
+		  PC_VERSION         pc version
+
+		Variables without a value, such as PC_ACCESS_TOKEN when only an API key
+		is configured, are left unset.
+
+		The default API key doesn't expire, so plugins aren't given it when a
+		short-lived token is available. For plugins that need an API key anyway,
+		run 'pc config set plugin-api-key true' or set PINECONE_PLUGIN_API_KEY=true.
+	`, plugin.Dir())
+)
+
+func NewPluginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Find and manage pc-<name> plugins",
+		Long:  pluginHelp,
+	}
+
+	cmd.AddCommand(NewListCmd())
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/plugin"
+	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/spf13/cobra"
+)
+
+type listCmdOptions struct {
+	json bool
+}
+
+type pluginEntry struct {
+	plugin.Plugin
+	// ShadowedBy names the built-in command or alias that runs instead.
+	ShadowedBy string `json:"shadowed_by,omitempty"`
+}
+
+func NewListCmd() *cobra.Command {
+	options := listCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List installed plugins",
+		Example: help.Examples(`
+		    pc plugin list
+		    pc plugin list --json
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			entries := listPlugins(cmd.Root(), plugin.Discover(plugin.SearchPath()))
+
+			if options.json {
+				fmt.Fprintln(os.Stdout, text.IndentJSON(entries))
+				return
+			}
+			if len(entries) == 0 {
+				msg.InfoMsg("No plugins found. Add an executable named %s to %s or your PATH.", style.Code("pc-<name>"), plugin.Dir())
+				return
+			}
+
+			w := presenters.NewTabWriter()
+			fmt.Fprintln(w, "NAME\tPATH\tSTATUS")
+			for _, e := range entries {
+				status := "ok"
+				if e.ShadowedBy != "" {
+					status = "shadowed by " + e.ShadowedBy
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", e.Name, e.Path, status)
+			}
+			w.Flush()
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+
+	return cmd
+}
+
+// listPlugins marks the plugins whose name is taken by a command of root that
+// isn't the plugin itself.
+func listPlugins(root *cobra.Command, plugins []plugin.Plugin) []pluginEntry {
+	entries := make([]pluginEntry, 0, len(plugins))
+	for _, p := range plugins {
+		e := pluginEntry{Plugin: p}
+		if p.Name == "help" || p.Name == "completion" {
+			e.ShadowedBy = "built-in command"
+		}
+		for _, cmd := range root.Commands() {
+			if cmd.Name() != p.Name && !cmd.HasAlias(p.Name) {
+				continue
+			}
+			switch cmd.GroupID {
+			case help.GROUP_PLUGINS.ID:
+			case help.GROUP_ALIASES.ID:
+				e.ShadowedBy = "alias"
+			default:
+				e.ShadowedBy = "built-in command"
+			}
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
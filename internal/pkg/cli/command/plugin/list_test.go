@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/plugin"
+	"github.com/spf13/cobra"
+)
+
+func TestListPlugins(t *testing.T) {
+	root := &cobra.Command{Use: "pc"}
+	root.AddGroup(help.GROUP_ALIASES, help.GROUP_PLUGINS)
+	root.AddCommand(
+		&cobra.Command{Use: "index", Aliases: []string{"idx"}},
+		&cobra.Command{Use: "ls", GroupID: help.GROUP_ALIASES.ID},
+		&cobra.Command{Use: "hello", GroupID: help.GROUP_PLUGINS.ID},
+	)
+
+	entries := listPlugins(root, []plugin.Plugin{
+		{Name: "hello"}, {Name: "help"}, {Name: "idx"}, {Name: "ls"},
+	})
+
+	want := map[string]string{"hello": "", "help": "built-in command", "idx": "built-in command", "ls": "alias"}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for _, e := range entries {
+		if e.ShadowedBy != want[e.Name] {
+			t.Errorf("%s: ShadowedBy = %q, want %q", e.Name, e.ShadowedBy, want[e.Name])
+		}
+	}
+}
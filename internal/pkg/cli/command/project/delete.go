@@ -77,6 +77,7 @@ func NewDeleteProjectCmd() *cobra.Command {
 				msg.FailJSON(options.json, "Failed to delete project %s: %s\n", style.Emphasis(projToDelete.Name), err)
 				exit.Errorf(err, "Failed to delete project %s", style.Emphasis(projToDelete.Name))
 			}
+			sdk.InvalidateProject(projToDelete.Id)
 
 			// Clear target project if the deleted project is the target project
 			if state.TargetProj.Get().Name == projToDelete.Name {
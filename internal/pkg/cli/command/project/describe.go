@@ -1,9 +1,6 @@
 package project
 
 import (
-	"fmt"
-	"os"
-
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
@@ -11,13 +8,13 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/spf13/cobra"
 )
 
 type describeProjectCmdOptions struct {
 	projectID string
 	json      bool
+	output    presenters.OutputFormat
 }
 
 func NewDescribeProjectCmd() *cobra.Command {
@@ -39,23 +36,20 @@ func NewDescribeProjectCmd() *cobra.Command {
 			if projId == "" {
 				projId, err = state.GetTargetProjectId()
 				if err != nil {
-					msg.FailJSON(options.json, "No target project set and no project ID provided. Use %s to set the target project. Use %s to describe a specific project.", style.Code("pc target -p <project>"), style.Code("pc project describe -i <project-id>"))
+					msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "No target project set and no project ID provided. Use %s to set the target project. Use %s to describe a specific project.", style.Code("pc target -p <project>"), style.Code("pc project describe -i <project-id>"))
 					exit.ErrorMsg("No project ID provided, and no target project set")
 				}
 			}
 
 			project, err := ac.Project.Describe(ctx, projId)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to describe project %s: %s\n", projId, err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to describe project %s: %s\n", projId, err)
 				exit.Errorf(err, "Failed to describe project %s", style.Emphasis(projId))
 			}
 
-			if options.json {
-				json := text.IndentJSON(project)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
+			presenters.PrintOutput(options.output.WithJSON(options.json), project, func() {
 				presenters.PrintDescribeProjectTable(project)
-			}
+			})
 		},
 	}
 
@@ -65,6 +59,7 @@ func NewDescribeProjectCmd() *cobra.Command {
 
 	// optional flags
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
 
 	return cmd
 }
@@ -1,23 +1,21 @@
 package project
 
 import (
-	"fmt"
-	"os"
 	"strconv"
-	"strings"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
 	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
-	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/spf13/cobra"
 )
 
 type listProjectCmdOptions struct {
-	json bool
+	json   bool
+	output presenters.OutputFormat
+	list   presenters.ListOptions
 }
 
 func NewListProjectsCmd() *cobra.Command {
@@ -36,31 +34,30 @@ func NewListProjectsCmd() *cobra.Command {
 
 			projects, err := ac.Project.List(ctx)
 			if err != nil {
-				msg.FailJSON(options.json, "Failed to list projects: %s\n", err)
+				msg.FailJSON(options.output.WithJSON(options.json).IsJSON(), "Failed to list projects: %s\n", err)
 				exit.Error(err, "Failed to list projects")
 			}
 
-			if options.json {
-				json := text.IndentJSON(projects)
-				fmt.Fprintln(os.Stdout, json)
-			} else {
-				printTable(projects)
-			}
+			presenters.PrintList(options.output.WithJSON(options.json), options.list, projects, projectTable(projects), presenters.PrintTable)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&options.json, "json", "j", false, "Output as JSON")
+	cmd.Flags().VarP(&options.output, "output", "o", presenters.OutputFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Columns, "columns", nil, presenters.ColumnsFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.SortBy, "sort-by", nil, presenters.SortByFlagUsage)
+	cmd.Flags().StringSliceVar(&options.list.Where, "where", nil, presenters.WhereFlagUsage)
 
 	return cmd
 }
 
-func printTable(projects []*pinecone.Project) {
-	writer := presenters.NewTabWriter()
-
-	columns := []string{"NAME", "ID", "ORGANIZATION ID", "CREATED AT", "FORCE ENCRYPTION", "MAX PODS"}
-	header := strings.Join(columns, "\t") + "\n"
-	fmt.Fprint(writer, header)
-
+func projectTable(projects []*pinecone.Project) presenters.Table {
+	table := presenters.Table{
+		Resource: "projects",
+		Columns: []presenters.TableColumn{
+			{Header: "NAME"}, {Header: "ID"}, {Header: "ORGANIZATION ID"}, {Header: "CREATED AT"}, {Header: "FORCE ENCRYPTION"}, {Header: "MAX PODS"},
+		},
+	}
 	for _, proj := range projects {
 		values := []string{
 			proj.Name,
@@ -69,7 +66,8 @@ func printTable(projects []*pinecone.Project) {
 			proj.CreatedAt.String(),
 			strconv.FormatBool(proj.ForceEncryptionWithCmek),
 			strconv.Itoa(proj.MaxPods)}
-		fmt.Fprint(writer, strings.Join(values, "\t")+"\n")
+		table.Rows = append(table.Rows, values)
+		table.Records = append(table.Records, proj)
 	}
-	writer.Flush()
+	return table
 }
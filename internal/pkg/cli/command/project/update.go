@@ -66,6 +66,7 @@ func NewUpdateProjectCmd() *cobra.Command {
 				msg.FailJSON(options.json, "Failed to update project %s: %s\n", projId, err)
 				exit.Errorf(err, "Failed to update project %s", style.Emphasis(projId))
 			}
+			sdk.InvalidateProject(projId)
 
 			if options.json {
 				json := text.IndentJSON(project)
@@ -0,0 +1,92 @@
+package root
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/alias"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	conf "github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/spf13/cobra"
+)
+
+// completeCommands are cobra's hidden commands that shell completion scripts
+// call with the command line being completed.
+var completeCommands = []string{cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd}
+
+// reserveCommandNames stops aliases from shadowing built-in commands.
+func reserveCommandNames(root *cobra.Command) {
+	alias.Reserve("help", "completion")
+	alias.Reserve(completeCommands...)
+	for _, cmd := range root.Commands() {
+		alias.Reserve(cmd.Name())
+		alias.Reserve(cmd.Aliases...)
+	}
+}
+
+// addAliasCommands lists the user's aliases in the root help. The commands
+// only run if an alias expands to itself, since Execute expands aliases
+// before cobra sees the arguments.
+func addAliasCommands(root *cobra.Command, aliases map[string]string) {
+	if len(aliases) == 0 {
+		return
+	}
+	root.AddGroup(help.GROUP_ALIASES)
+	for name, expansion := range aliases {
+		if alias.ValidateName(name) != nil {
+			continue
+		}
+		root.AddCommand(&cobra.Command{
+			Use:                name,
+			Short:              "Alias for: pc " + expansion,
+			GroupID:            help.GROUP_ALIASES.ID,
+			DisableFlagParsing: true,
+			Run: func(cmd *cobra.Command, args []string) {
+				msg.FailMsg("Alias %s expands to itself; fix it with %s", style.Emphasis(name), style.Code("pc alias set "+name+" <expansion>"))
+				exit.Error(clierr.New(clierr.CodeUsage, "alias %s expands to itself", name), "Invalid alias")
+			},
+		})
+	}
+}
+
+// expandAliases replaces an alias in the first argument with its expansion.
+// Arguments passed to cobra's completion commands are expanded the same way,
+// so completion works after an alias too.
+func expandAliases(args []string, aliases map[string]string) ([]string, error) {
+	if len(args) == 0 {
+		return args, nil
+	}
+	if slices.Contains(completeCommands, args[0]) {
+		expanded, err := expandAliases(args[1:], aliases)
+		if err != nil {
+			// An alias still missing arguments completes as typed
+			return args, nil
+		}
+		return append([]string{args[0]}, expanded...), nil
+	}
+
+	expansion, ok := aliases[args[0]]
+	if !ok || alias.ValidateName(args[0]) != nil {
+		return args, nil
+	}
+	expanded, err := alias.Expand(expansion, args[1:])
+	if err != nil {
+		return nil, fmt.Errorf("alias %s: %w", args[0], err)
+	}
+	log.Debug().Str("alias", args[0]).Strs("args", expanded).Msg("Expanded alias")
+	return expanded, nil
+}
+
+// applyAliases sets up aliases for this run and returns the arguments to
+// execute.
+func applyAliases(root *cobra.Command, args []string) ([]string, error) {
+	reserveCommandNames(root)
+	aliases := conf.Aliases.Get()
+	addAliasCommands(root, aliases)
+	return expandAliases(args, aliases)
+}
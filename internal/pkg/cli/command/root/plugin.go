@@ -0,0 +1,70 @@
+package root
+
+import (
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/plugin"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/spf13/cobra"
+)
+
+// needsPlugins reports whether args might run or list a plugin. Commands
+// that are built in skip the PATH scan.
+func needsPlugins(root *cobra.Command, args []string) bool {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return true
+	}
+	switch args[0] {
+	case "help", cobra.ShellCompRequestCmd, cobra.ShellCompNoDescRequestCmd:
+		return true
+	}
+	cmd, _, err := root.Find(args[:1])
+	return err != nil || cmd == root
+}
+
+// addPluginCommands adds a command for every pc-<name> plugin that doesn't
+// clash with a built-in command or alias, listed under "Plugins" in help.
+func addPluginCommands(root *cobra.Command, plugins []plugin.Plugin) {
+	taken := map[string]bool{"help": true, "completion": true}
+	for _, cmd := range root.Commands() {
+		taken[cmd.Name()] = true
+		for _, a := range cmd.Aliases {
+			taken[a] = true
+		}
+	}
+
+	added := false
+	for _, p := range plugins {
+		if taken[p.Name] {
+			continue
+		}
+		if !added {
+			root.AddGroup(help.GROUP_PLUGINS)
+			added = true
+		}
+		root.AddCommand(newPluginCmd(p))
+		// Plugins get whatever credentials are available and check them
+		// themselves.
+		skipAuthCommands[root.Name()+" "+p.Name] = struct{}{}
+	}
+}
+
+func newPluginCmd(p plugin.Plugin) *cobra.Command {
+	return &cobra.Command{
+		Use:                p.Name,
+		Short:              "Plugin " + p.Path,
+		GroupID:            help.GROUP_PLUGINS.ID,
+		DisableFlagParsing: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			code, err := plugin.Run(p, args, plugin.ResolveContext(cmd.Context()))
+			if err != nil {
+				msg.FailMsg("Failed to run plugin %s: %s", style.Emphasis(p.Name), err)
+				exit.Error(err, "Failed to run plugin")
+			}
+			exit.Code(code)
+		},
+	}
+}
@@ -2,30 +2,54 @@ package root
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"golang.org/x/term"
 
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/api"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/apiKey"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/auth"
+	pccache "github.com/pinecone-io/cli/internal/pkg/cli/command/cache"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/config"
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/doctor"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/index"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/login"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/logout"
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/logs"
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/mcp"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/organization"
+	pcplugin "github.com/pinecone-io/cli/internal/pkg/cli/command/plugin"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/project"
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/serve"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/target"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/version"
 	"github.com/pinecone-io/cli/internal/pkg/cli/command/whoami"
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/completion"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	conf "github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
 	loginutil "github.com/pinecone-io/cli/internal/pkg/utils/login"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/plugin"
 	"github.com/pinecone-io/cli/internal/pkg/utils/pluginhint"
+	"github.com/pinecone-io/cli/internal/pkg/utils/presenters"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"github.com/pinecone-io/cli/internal/pkg/utils/tracing"
+	"github.com/pinecone-io/cli/internal/pkg/utils/watch"
+	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
@@ -38,32 +62,63 @@ var (
 // These are commands that either establish credentials or work on local state only.
 // When adding new commands that don't need auth, add their CommandPath() here.
 var skipAuthCommands = map[string]struct{}{
-	"pc login":                   {},
-	"pc logout":                  {},
-	"pc auth login":              {},
-	"pc auth logout":             {},
-	"pc auth configure":          {},
-	"pc auth clear":              {},
-	"pc auth status":             {},
-	"pc auth _daemon":            {},
-	"pc auth local-keys":         {}, // parent command (shows help)
-	"pc auth local-keys list":    {}, // reads local state only, no API calls
-	"pc target":                  {}, // handles its own auth after --show/--clear early returns
-	"pc version":                 {},
-	"pc config":                  {},
-	"pc config get":              {},
-	"pc config set":              {},
-	"pc config unset":            {},
-	"pc config list":             {},
-	"pc config describe":         {},
-	"pc config get-api-key":      {},
-	"pc config set-api-key":      {},
-	"pc config set-color":        {},
-	"pc config set-environment":  {},
+	"pc login":                     {},
+	"pc logout":                    {},
+	"pc auth login":                {},
+	"pc auth logout":               {},
+	"pc auth configure":            {},
+	"pc auth clear":                {},
+	"pc auth status":               {},
+	"pc auth _daemon":              {},
+	"pc auth local-keys":           {}, // parent command (shows help)
+	"pc auth local-keys list":      {}, // reads local state only, no API calls
+	"pc auth migrate-secrets":      {}, // moves local secrets between storage backends
+	"pc target":                    {}, // handles its own auth after --show/--clear early returns
+	"pc version":                   {},
+	"pc config":                    {},
+	"pc config get":                {},
+	"pc config set":                {},
+	"pc config unset":              {},
+	"pc config list":               {},
+	"pc config describe":           {},
+	"pc config environment":        {},
+	"pc config environment add":    {},
+	"pc config environment list":   {},
+	"pc config environment remove": {},
+	"pc config get-api-key":        {},
+	"pc config set-api-key":        {},
+	"pc config set-color":          {},
+	"pc config set-environment":    {},
+	"pc doctor":                    {}, // reports missing credentials instead of failing on them
+	"pc index import validate":     {}, // reads storage; --index-name authenticates on its own
+	"pc index import prepare":      {}, // writes local files; --index-name authenticates on its own
+	"pc logs":                      {},
+	"pc logs show":                 {},
+	"pc logs tail":                 {},
+	"pc alias":                     {},
+	"pc alias set":                 {},
+	"pc alias list":                {},
+	"pc alias delete":              {},
+	"pc plugin":                    {},
+	"pc plugin list":               {},
+	"pc cache":                     {},
+	"pc cache clear":               {},
+	"pc completion":                {},
+	"pc completion bash":           {},
+	"pc completion zsh":            {},
+	"pc completion fish":           {},
+	"pc completion powershell":     {},
+	"pc __complete":                {}, // completion functions authenticate on their own and fail quietly
+	"pc __completeNoDesc":          {},
 }
 
 type GlobalOptions struct {
-	timeout time.Duration
+	timeout   time.Duration
+	debug     bool
+	traceHTTP bool
+	traceFile string
+	local     bool
+	noCache   bool
 }
 
 func Execute() {
@@ -75,11 +130,52 @@ func Execute() {
 	// Base context: cancel on SIGINT / SIGTERM
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 
-	err := rootCmd.ExecuteContext(ctx)
+	args, err := applyAliases(rootCmd, os.Args[1:])
+	if err != nil {
+		msg.FailMsg("%s", err)
+		exit.Error(clierr.Wrap(clierr.CodeUsage, err), "Failed to expand alias")
+	}
+	if needsPlugins(rootCmd, args) {
+		addPluginCommands(rootCmd, plugin.Discover(plugin.SearchPath()))
+	}
+	rootCmd.SetArgs(args)
+
+	err = rootCmd.ExecuteContext(ctx)
 	cancel()
 	if err != nil {
-		os.Exit(1)
+		// Commands report their own failures and exit; errors returned here come
+		// from cobra itself: unknown commands, bad flags or arguments. Cobra has
+		// printed the error; JSON callers also get the envelope on stdout.
+		if jsonErrorsRequested(args) {
+			usageErr := clierr.Wrap(clierr.CodeUsage, err)
+			fmt.Fprintln(os.Stdout, text.IndentJSON(msg.ErrorEnvelope(usageErr, err.Error())))
+		}
+		os.Exit(clierr.ExitUsage)
+	}
+}
+
+// jsonErrorsRequested reports whether args ask for JSON output with --json,
+// -j or -o/--output json, before any "--". It reads the raw arguments because
+// flag parsing may be what failed.
+func jsonErrorsRequested(args []string) bool {
+	for i, arg := range args {
+		switch {
+		case arg == "--":
+			return false
+		case arg == "--json", arg == "-j", arg == "--json=true":
+			return true
+		case arg == "-o" || arg == "--output":
+			if i+1 < len(args) && strings.EqualFold(args[i+1], presenters.OutputJSON) {
+				return true
+			}
+		case strings.HasPrefix(arg, "--output="), strings.HasPrefix(arg, "-o"):
+			value := strings.TrimPrefix(strings.TrimPrefix(strings.TrimPrefix(arg, "--output="), "-o"), "=")
+			if strings.EqualFold(value, presenters.OutputJSON) {
+				return true
+			}
+		}
 	}
+	return false
 }
 
 func GetRootCmd() *cobra.Command {
@@ -120,11 +216,33 @@ func init() {
 		Use:   "pc",
 		Short: "Manage your Pinecone vector database infrastructure from the command line",
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
-			// Apply timeout to the command context
+			configureLogFile()
+			applyDebugOptions()
+			logCommand(cmd)
+
+			// Apply timeout to the command context. With --watch it applies
+			// to each refresh, since watching runs until interrupted.
 			if globalOptions.timeout > 0 {
-				ctx, cancel := context.WithTimeout(cmd.Context(), globalOptions.timeout)
-				cancelRootFunc = cancel
-				cmd.SetContext(ctx)
+				if watch.Enabled(cmd) {
+					cmd.SetContext(watch.WithPollTimeout(cmd.Context(), globalOptions.timeout))
+				} else {
+					ctx, cancel := context.WithTimeout(cmd.Context(), globalOptions.timeout)
+					cancelRootFunc = cancel
+					cmd.SetContext(ctx)
+				}
+			}
+
+			// Fill --index-name and --namespace from a .pinecone.yaml project file
+			// before cobra validates required flags.
+			if err := projectfile.Load().ApplyFlagDefaults(cmd.Flags()); err != nil {
+				exit.Error(err, "Error applying defaults from project file")
+			}
+
+			if globalOptions.local {
+				local.Enable()
+			}
+			if globalOptions.noCache {
+				cache.Disable()
 			}
 
 			// Skip auth check for commands that establish or manage credentials.
@@ -132,6 +250,11 @@ func init() {
 				return
 			}
 
+			// Pinecone Local accepts any API key, so there is nothing to check.
+			if local.Enabled() {
+				return
+			}
+
 			// JSON mode: non-TTY stdout OR the command's own --json/-j flag was set.
 			isJSON := !term.IsTerminal(int(os.Stdout.Fd()))
 			if !isJSON {
@@ -185,13 +308,76 @@ func init() {
 	// Misc group
 	rootCmd.AddCommand(version.NewVersionCmd())
 	rootCmd.AddCommand(config.NewConfigCmd())
+	rootCmd.AddCommand(logs.NewLogsCmd())
+	rootCmd.AddCommand(doctor.NewDoctorCmd())
+	rootCmd.AddCommand(mcp.NewMCPCmd())
+	rootCmd.AddCommand(serve.NewServeCmd())
+	rootCmd.AddCommand(api.NewAPICmd())
+	rootCmd.AddCommand(pccache.NewCacheCmd())
+	rootCmd.AddCommand(config.NewAliasCmd())
+	rootCmd.AddCommand(pcplugin.NewPluginCmd())
+
+	// Shell completion, with live index, namespace, project and backup names
+	completion.Register(rootCmd)
 
 	// Declutter default stuff
-	rootCmd.CompletionOptions.HiddenDefaultCmd = true
 	rootCmd.SetHelpCommand(&cobra.Command{
 		Hidden: true,
 	})
 
 	// Global flags
 	rootCmd.PersistentFlags().DurationVar(&globalOptions.timeout, "timeout", defaultTimeout, "timeout for commands, defaults to 60s (0 to disable)")
+	rootCmd.PersistentFlags().BoolVar(&globalOptions.debug, "debug", false, "log debug output and trace HTTP and gRPC requests to stderr")
+	rootCmd.PersistentFlags().BoolVar(&globalOptions.traceHTTP, "trace-http", false, "trace HTTP and gRPC requests and responses to stderr, with credentials redacted")
+	rootCmd.PersistentFlags().StringVar(&globalOptions.traceFile, "trace-file", "", "write the --debug/--trace-http trace to this file instead of stderr")
+	rootCmd.PersistentFlags().BoolVar(&globalOptions.local, "local", false, "target Pinecone Local at $PINECONE_LOCAL_HOST (default http://localhost:5080) without authentication")
+	rootCmd.PersistentFlags().BoolVar(&globalOptions.noCache, "no-cache", false, "don't use cached index hosts, project details or completion values")
+}
+
+// applyDebugOptions turns on debug logging and request tracing for --debug,
+// --trace-http and --trace-file.
+func applyDebugOptions() {
+	if globalOptions.debug {
+		log.SetConsoleLevel(zerolog.DebugLevel)
+	}
+	if !globalOptions.debug && !globalOptions.traceHTTP && globalOptions.traceFile == "" {
+		return
+	}
+
+	if globalOptions.traceFile == "" {
+		tracing.Enable(os.Stderr)
+		return
+	}
+	f, err := tracing.OpenFile(globalOptions.traceFile)
+	if err != nil {
+		msg.FailMsg("Failed to open trace file %s: %s", globalOptions.traceFile, err)
+		exit.Error(clierr.Wrap(clierr.CodeUsage, err), "Failed to open trace file")
+	}
+	tracing.Enable(f)
+}
+
+// configureLogFile starts the JSON-lines log file when log-level is set. A log
+// file that can't be opened is reported but doesn't fail the command.
+func configureLogFile() {
+	level, err := log.ParseLevel(conf.LogLevel.Get())
+	if err != nil {
+		msg.WarnMsg("Ignoring log-level: %s", err)
+		return
+	}
+	if level == zerolog.Disabled {
+		return
+	}
+	if err := log.EnableFile(configuration.LogsDirPath(), level); err != nil {
+		msg.WarnMsg("Failed to open the log file in %s: %s", configuration.LogsDirPath(), err)
+	}
+}
+
+// logCommand records which command is running. Only the names of the flags
+// given are logged, since values such as API keys may be secret.
+func logCommand(cmd *cobra.Command) {
+	var flags []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		flags = append(flags, f.Name)
+	})
+	log.Info().Str("command", cmd.CommandPath()).Strs("flags", flags).Msg("running command")
 }
@@ -0,0 +1,69 @@
+package root
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
+)
+
+func TestJSONErrorsRequested(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"index", "list", "--json"}, true},
+		{[]string{"index", "list", "-j", "--bogus"}, true},
+		{[]string{"index", "list", "-o", "json"}, true},
+		{[]string{"index", "list", "--output=JSON"}, true},
+		{[]string{"index", "list", "-ojson"}, true},
+		{[]string{"index", "list", "-o=json"}, true},
+		{[]string{"index", "list", "-o", "yaml"}, false},
+		{[]string{"index", "list", "--output", "jsonpath={.name}"}, false},
+		{[]string{"index", "list"}, false},
+		{[]string{"my-plugin", "--", "--json"}, false},
+	}
+	for _, tt := range tests {
+		if got := jsonErrorsRequested(tt.args); got != tt.want {
+			t.Errorf("jsonErrorsRequested(%q) = %v, want %v", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestProjectFileDefaultsSkipDestructiveCommands(t *testing.T) {
+	pf := &projectfile.ProjectFile{IndexName: "prod-docs", Namespace: "en"}
+	for _, path := range []string{
+		"index delete",
+		"index configure",
+		"index namespace delete",
+		"index namespace create",
+		"index vector delete",
+		"index import cancel",
+		"index backup create",
+		"index backup list",
+	} {
+		cmd, _, err := rootCmd.Find(strings.Fields(path))
+		if err != nil || cmd.CommandPath() != "pc "+path {
+			t.Fatalf("pc %s: not found: %v", path, err)
+		}
+		if err := pf.ApplyFlagDefaults(cmd.Flags()); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		for _, name := range []string{"index-name", "namespace"} {
+			if cmd.Flags().Changed(name) {
+				t.Errorf("pc %s: --%s was filled in from the project file", path, name)
+			}
+		}
+	}
+
+	cmd, _, err := rootCmd.Find([]string{"index", "vector", "query"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := pf.ApplyFlagDefaults(cmd.Flags()); err != nil {
+		t.Fatal(err)
+	}
+	if got := cmd.Flags().Lookup("index-name").Value.String(); got != "prod-docs" {
+		t.Errorf("pc index vector query --index-name = %q, want the project file's", got)
+	}
+}
@@ -0,0 +1,106 @@
+package serve
+
+import (
+	"context"
+
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/index/vector"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// backend is what the gateway needs from Pinecone. sdkBackend implements it;
+// tests use a fake.
+type backend interface {
+	ListIndexes(ctx context.Context) ([]*pinecone.Index, error)
+	DescribeIndex(ctx context.Context, index string) (*pinecone.Index, error)
+	Stats(ctx context.Context, index string) (any, error)
+	Query(ctx context.Context, index, namespace string, body vector.QueryBody) (any, error)
+	Search(ctx context.Context, index, namespace string, req pinecone.SearchRecordsRequest) (any, error)
+	Fetch(ctx context.Context, index, namespace string, body vector.FetchBody) (any, error)
+	Upsert(ctx context.Context, index, namespace string, vectors []pinecone.Vector) (any, error)
+	UpsertRecords(ctx context.Context, index, namespace string, records []pinecone.IntegratedRecord) (any, error)
+}
+
+const (
+	vectorBatchSize = 500
+	recordBatchSize = 96
+)
+
+type sdkBackend struct {
+	pc    *pinecone.Client
+	conns *sdk.IndexConnectionCache
+}
+
+func newSDKBackend(pc *pinecone.Client) *sdkBackend {
+	return &sdkBackend{pc: pc, conns: sdk.NewIndexConnectionCache(pc)}
+}
+
+func (b *sdkBackend) ListIndexes(ctx context.Context) ([]*pinecone.Index, error) {
+	return b.pc.ListIndexes(ctx)
+}
+
+func (b *sdkBackend) DescribeIndex(ctx context.Context, index string) (*pinecone.Index, error) {
+	return b.pc.DescribeIndex(ctx, index)
+}
+
+func (b *sdkBackend) Stats(ctx context.Context, index string) (any, error) {
+	ic, err := b.conns.Get(ctx, index, "")
+	if err != nil {
+		return nil, err
+	}
+	return ic.DescribeIndexStats(ctx)
+}
+
+func (b *sdkBackend) Query(ctx context.Context, index, namespace string, body vector.QueryBody) (any, error) {
+	ic, err := b.conns.Get(ctx, index, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return vector.Query(ctx, ic, body)
+}
+
+func (b *sdkBackend) Search(ctx context.Context, index, namespace string, req pinecone.SearchRecordsRequest) (any, error) {
+	ic, err := b.conns.Get(ctx, index, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return ic.SearchRecords(ctx, &req)
+}
+
+func (b *sdkBackend) Fetch(ctx context.Context, index, namespace string, body vector.FetchBody) (any, error) {
+	ic, err := b.conns.Get(ctx, index, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return vector.Fetch(ctx, ic, body)
+}
+
+func (b *sdkBackend) Upsert(ctx context.Context, index, namespace string, vectors []pinecone.Vector) (any, error) {
+	ic, err := b.conns.Get(ctx, index, namespace)
+	if err != nil {
+		return nil, err
+	}
+	upserted, err := vector.UpsertInBatches(ctx, ic, vectors, vectorBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"upserted_count": upserted}, nil
+}
+
+func (b *sdkBackend) UpsertRecords(ctx context.Context, index, namespace string, records []pinecone.IntegratedRecord) (any, error) {
+	ic, err := b.conns.Get(ctx, index, namespace)
+	if err != nil {
+		return nil, err
+	}
+	ptrs := make([]*pinecone.IntegratedRecord, len(records))
+	for i := range records {
+		ptrs[i] = &records[i]
+	}
+	for start := 0; start < len(ptrs); start += recordBatchSize {
+		end := min(start+recordBatchSize, len(ptrs))
+		if err := ic.UpsertRecords(ctx, ptrs[start:end]); err != nil {
+			return nil, err
+		}
+	}
+	return map[string]any{"upserted_count": len(records)}, nil
+}
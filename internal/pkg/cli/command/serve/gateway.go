@@ -0,0 +1,371 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/index/record"
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/index/vector"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// maxBodyBytes caps request bodies; upserts larger than this should use
+// pc index vector upsert or a bulk import.
+const maxBodyBytes = 32 << 20
+
+// statusClientClosedRequest is the nginx convention for a request whose
+// client went away before the response was ready.
+const statusClientClosedRequest = 499
+
+type gatewayConfig struct {
+	// indexes is the allow-list; empty allows every index in the project.
+	indexes      []string
+	readOnly     bool
+	allowOrigins []string
+	// host is the --host the gateway listens on; requests must name it or a
+	// loopback address in their Host header.
+	host    string
+	timeout time.Duration
+}
+
+type gateway struct {
+	backend backend
+	config  gatewayConfig
+}
+
+type queryRequest struct {
+	Namespace string `json:"namespace"`
+	vector.QueryBody
+}
+
+type searchRequest struct {
+	Namespace string `json:"namespace"`
+	pinecone.SearchRecordsRequest
+}
+
+type fetchRequest struct {
+	Namespace string `json:"namespace"`
+	vector.FetchBody
+}
+
+type upsertRequest struct {
+	Namespace string `json:"namespace"`
+	vector.UpsertBody
+}
+
+type upsertRecordsRequest struct {
+	Namespace string `json:"namespace"`
+	record.UpsertRecordsBody
+}
+
+func newGateway(b backend, config gatewayConfig) http.Handler {
+	g := &gateway{backend: b, config: config}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"status": "ok", "read_only": config.readOnly})
+	})
+	mux.HandleFunc("GET /indexes", g.listIndexes)
+	mux.HandleFunc("GET /indexes/{index}", g.indexHandler(func(ctx context.Context, index string, _ []byte) (any, error) {
+		return g.backend.DescribeIndex(ctx, index)
+	}))
+	mux.HandleFunc("GET /indexes/{index}/stats", g.indexHandler(func(ctx context.Context, index string, _ []byte) (any, error) {
+		return g.backend.Stats(ctx, index)
+	}))
+	mux.HandleFunc("POST /indexes/{index}/query", g.indexHandler(func(ctx context.Context, index string, body []byte) (any, error) {
+		req, err := decode[queryRequest](body)
+		if err != nil {
+			return nil, err
+		}
+		return g.backend.Query(ctx, index, req.Namespace, req.QueryBody)
+	}))
+	mux.HandleFunc("POST /indexes/{index}/search", g.indexHandler(func(ctx context.Context, index string, body []byte) (any, error) {
+		req, err := decode[searchRequest](body)
+		if err != nil {
+			return nil, err
+		}
+		if req.Query.TopK <= 0 {
+			req.Query.TopK = vector.DefaultTopK
+		}
+		return g.backend.Search(ctx, index, req.Namespace, req.SearchRecordsRequest)
+	}))
+	mux.HandleFunc("POST /indexes/{index}/fetch", g.indexHandler(func(ctx context.Context, index string, body []byte) (any, error) {
+		req, err := decode[fetchRequest](body)
+		if err != nil {
+			return nil, err
+		}
+		return g.backend.Fetch(ctx, index, req.Namespace, req.FetchBody)
+	}))
+	mux.HandleFunc("POST /indexes/{index}/upsert", g.writeHandler(func(ctx context.Context, index string, body []byte) (any, error) {
+		req, err := decode[upsertRequest](body)
+		if err != nil {
+			return nil, err
+		}
+		if len(req.Vectors) == 0 {
+			return nil, clierr.New(clierr.CodeInvalidArgument, "no vectors provided")
+		}
+		return g.backend.Upsert(ctx, index, req.Namespace, req.Vectors)
+	}))
+	mux.HandleFunc("POST /indexes/{index}/records/upsert", g.writeHandler(func(ctx context.Context, index string, body []byte) (any, error) {
+		req, err := decode[upsertRecordsRequest](body)
+		if err != nil {
+			return nil, err
+		}
+		if len(req.Records) == 0 {
+			return nil, clierr.New(clierr.CodeInvalidArgument, "no records provided")
+		}
+		return g.backend.UpsertRecords(ctx, index, req.Namespace, req.Records)
+	}))
+
+	return g.withLogging(g.withHostCheck(g.withCORS(mux)))
+}
+
+type indexFunc func(ctx context.Context, index string, body []byte) (any, error)
+
+// indexHandler runs fn for an allowed index with the request body, applying
+// the per-request timeout and writing the result or error as JSON.
+func (g *gateway) indexHandler(fn indexFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		index := r.PathValue("index")
+		if !g.indexAllowed(index) {
+			writeError(w, clierr.New(clierr.CodeNotFound, "index %q is not served by this gateway", index))
+			return
+		}
+		if r.Method == http.MethodPost {
+			// Browsers send text/plain and form bodies cross-origin without a
+			// preflight; requiring JSON makes them preflight, which withCORS
+			// answers only for allowed origins.
+			if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+				writeError(w, &clierr.Error{
+					Code:       clierr.CodeInvalidArgument,
+					Message:    "request bodies must have Content-Type: application/json",
+					HTTPStatus: http.StatusUnsupportedMediaType,
+				})
+				return
+			}
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+		if err != nil {
+			writeError(w, clierr.New(clierr.CodeInvalidArgument, "failed to read request body: %s", err))
+			return
+		}
+
+		ctx, cancel := g.requestContext(r)
+		defer cancel()
+		out, err := fn(ctx, index, body)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		writeJSON(w, http.StatusOK, out)
+	}
+}
+
+// requestContext returns r's context with the per-request timeout applied.
+func (g *gateway) requestContext(r *http.Request) (context.Context, context.CancelFunc) {
+	if g.config.timeout > 0 {
+		return context.WithTimeout(r.Context(), g.config.timeout)
+	}
+	return context.WithCancel(r.Context())
+}
+
+// writeHandler is indexHandler for endpoints that change data, which are
+// refused in read-only mode.
+func (g *gateway) writeHandler(fn indexFunc) http.HandlerFunc {
+	inner := g.indexHandler(fn)
+	return func(w http.ResponseWriter, r *http.Request) {
+		if g.config.readOnly {
+			writeError(w, clierr.New(clierr.CodePermissionDenied, "the gateway is read-only"))
+			return
+		}
+		inner(w, r)
+	}
+}
+
+func (g *gateway) listIndexes(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := g.requestContext(r)
+	defer cancel()
+	indexes, err := g.backend.ListIndexes(ctx)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	allowed := make([]*pinecone.Index, 0, len(indexes))
+	for _, idx := range indexes {
+		if g.indexAllowed(idx.Name) {
+			allowed = append(allowed, idx)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"indexes": allowed})
+}
+
+func (g *gateway) indexAllowed(name string) bool {
+	return len(g.config.indexes) == 0 || slices.Contains(g.config.indexes, name)
+}
+
+// withCORS answers preflight requests and adds CORS headers for allowed
+// origins, and refuses requests from any other origin: browsers send some
+// cross-origin requests without asking first, and the gateway must not run
+// them with the user's credentials. Requests without an Origin, such as
+// those of curl, are let through.
+func (g *gateway) withCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin != "" && !g.originAllowed(origin) {
+			writeError(w, clierr.New(clierr.CodePermissionDenied, "origin %q is not allowed; start the gateway with --allow-origin %s", origin, origin))
+			return
+		}
+		if origin != "" {
+			h := w.Header()
+			if slices.Contains(g.config.allowOrigins, "*") {
+				h.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+				h.Add("Vary", "Origin")
+			}
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				h.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+				h.Set("Access-Control-Allow-Headers", "Content-Type")
+				h.Set("Access-Control-Max-Age", "600")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// withHostCheck refuses requests whose Host header names neither the
+// listen address nor a loopback address, so that a page on a host name
+// rebound to 127.0.0.1 can't read responses as same-origin.
+func (g *gateway) withHostCheck(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !g.hostAllowed(r.Host) {
+			writeError(w, clierr.New(clierr.CodePermissionDenied, "host %q is not allowed; call the gateway at its listen address", r.Host))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (g *gateway) hostAllowed(hostport string) bool {
+	host := hostport
+	if h, _, err := net.SplitHostPort(hostport); err == nil {
+		host = h
+	}
+	host = strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+	if strings.EqualFold(host, "localhost") || (g.config.host != "" && strings.EqualFold(host, g.config.host)) {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	// Listening on every interface, any of the machine's addresses may be
+	// used; IP literals can't be rebound.
+	listen := net.ParseIP(g.config.host)
+	return listen != nil && (listen.IsUnspecified() || listen.Equal(ip))
+}
+
+func (g *gateway) originAllowed(origin string) bool {
+	for _, allowed := range g.config.allowOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *gateway) withLogging(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		log.Info().
+			Str("method", r.Method).
+			Str("path", r.URL.Path).
+			Int("status", rec.status).
+			Dur("duration", time.Since(start)).
+			Msg("Gateway request")
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// decode unmarshals a request body, rejecting unknown fields so that typos
+// are reported rather than ignored.
+func decode[T any](body []byte) (T, error) {
+	var v T
+	if len(body) == 0 {
+		return v, clierr.New(clierr.CodeInvalidArgument, "request body is required")
+	}
+	dec := json.NewDecoder(strings.NewReader(string(body)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return v, clierr.New(clierr.CodeInvalidArgument, "invalid request body: %s", err)
+	}
+	return v, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Debug().Err(err).Msg("Failed to write gateway response")
+	}
+}
+
+// writeError writes err as the CLI's {"error": {...}} envelope, with an HTTP
+// status that matches its classification.
+func writeError(w http.ResponseWriter, err error) {
+	classified := clierr.Classify(err)
+	writeJSON(w, httpStatusFor(classified), clierr.Envelope{Error: classified})
+}
+
+func httpStatusFor(e *clierr.Error) int {
+	if e.HTTPStatus >= 400 {
+		return e.HTTPStatus
+	}
+	switch e.Code {
+	case clierr.CodeUsage, clierr.CodeInvalidArgument:
+		return http.StatusBadRequest
+	case clierr.CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case clierr.CodePermissionDenied:
+		return http.StatusForbidden
+	case clierr.CodeNotFound:
+		return http.StatusNotFound
+	case clierr.CodeConflict:
+		return http.StatusConflict
+	case clierr.CodeRateLimited:
+		return http.StatusTooManyRequests
+	case clierr.CodeTimeout:
+		return http.StatusGatewayTimeout
+	case clierr.CodeUnavailable:
+		return http.StatusBadGateway
+	case clierr.CodeCanceled:
+		return statusClientClosedRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,268 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/cli/command/index/vector"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBackend struct {
+	lastIndex     string
+	lastNamespace string
+	lastQuery     vector.QueryBody
+	upserted      []pinecone.Vector
+	listDeadline  time.Time
+	err           error
+}
+
+func (f *fakeBackend) ListIndexes(ctx context.Context) ([]*pinecone.Index, error) {
+	f.listDeadline, _ = ctx.Deadline()
+	return []*pinecone.Index{{Name: "allowed"}, {Name: "other"}}, f.err
+}
+
+func (f *fakeBackend) DescribeIndex(_ context.Context, index string) (*pinecone.Index, error) {
+	f.lastIndex = index
+	return &pinecone.Index{Name: index}, f.err
+}
+
+func (f *fakeBackend) Stats(_ context.Context, index string) (any, error) {
+	f.lastIndex = index
+	return map[string]any{"total_vector_count": 3}, f.err
+}
+
+func (f *fakeBackend) Query(_ context.Context, index, namespace string, body vector.QueryBody) (any, error) {
+	f.lastIndex, f.lastNamespace, f.lastQuery = index, namespace, body
+	return map[string]any{"matches": []any{}}, f.err
+}
+
+func (f *fakeBackend) Search(_ context.Context, index, namespace string, _ pinecone.SearchRecordsRequest) (any, error) {
+	f.lastIndex, f.lastNamespace = index, namespace
+	return map[string]any{}, f.err
+}
+
+func (f *fakeBackend) Fetch(_ context.Context, index, namespace string, _ vector.FetchBody) (any, error) {
+	f.lastIndex, f.lastNamespace = index, namespace
+	return map[string]any{}, f.err
+}
+
+func (f *fakeBackend) Upsert(_ context.Context, index, namespace string, vectors []pinecone.Vector) (any, error) {
+	f.lastIndex, f.lastNamespace, f.upserted = index, namespace, vectors
+	return map[string]any{"upserted_count": len(vectors)}, f.err
+}
+
+func (f *fakeBackend) UpsertRecords(_ context.Context, index, namespace string, records []pinecone.IntegratedRecord) (any, error) {
+	f.lastIndex, f.lastNamespace = index, namespace
+	return map[string]any{"upserted_count": len(records)}, f.err
+}
+
+// do sends a request the way a local client would, to 127.0.0.1:8080 with
+// JSON bodies; header pairs override that, with "Host" setting the Host.
+func do(h http.Handler, method, path, body string, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Host = "127.0.0.1:8080"
+	if method == http.MethodPost {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		if header[i] == "Host" {
+			req.Host = header[i+1]
+			continue
+		}
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+	var env struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &env), rec.Body.String())
+	return env.Error.Code
+}
+
+func TestGatewayQuery(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{})
+
+	rec := do(h, http.MethodPost, "/indexes/my-index/query", `{"namespace":"ns1","vector":[0.1,0.2],"top_k":3}`)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "my-index", b.lastIndex)
+	assert.Equal(t, "ns1", b.lastNamespace)
+	assert.Equal(t, []float32{0.1, 0.2}, b.lastQuery.Vector)
+	require.NotNil(t, b.lastQuery.TopK)
+	assert.Equal(t, uint32(3), *b.lastQuery.TopK)
+}
+
+func TestGatewayRejectsInvalidBodies(t *testing.T) {
+	h := newGateway(&fakeBackend{}, gatewayConfig{})
+
+	rec := do(h, http.MethodPost, "/indexes/my-index/query", `{"vectr":[0.1]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "invalid_argument", errorCode(t, rec))
+
+	rec = do(h, http.MethodPost, "/indexes/my-index/upsert", `{"vectors":[]}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = do(h, http.MethodGet, "/indexes/my-index/query", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestGatewayIndexAllowList(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{indexes: []string{"allowed"}})
+
+	rec := do(h, http.MethodGet, "/indexes", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"allowed"`)
+	assert.NotContains(t, rec.Body.String(), `"other"`)
+
+	rec = do(h, http.MethodPost, "/indexes/other/query", `{"id":"a"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, b.lastIndex, "the backend isn't called for indexes outside the allow-list")
+
+	rec = do(h, http.MethodGet, "/indexes/allowed/stats", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGatewayReadOnly(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{readOnly: true})
+
+	rec := do(h, http.MethodPost, "/indexes/my-index/upsert", `{"vectors":[{"id":"a","values":[0.1]}]}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "permission_denied", errorCode(t, rec))
+	assert.Nil(t, b.upserted)
+
+	rec = do(h, http.MethodPost, "/indexes/my-index/records/upsert", `{"records":[{"_id":"a"}]}`)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	rec = do(h, http.MethodPost, "/indexes/my-index/query", `{"id":"a"}`)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGatewayUpsert(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{})
+
+	rec := do(h, http.MethodPost, "/indexes/my-index/upsert", `{"namespace":"ns","vectors":[{"id":"a","values":[0.1]}]}`)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Len(t, b.upserted, 1)
+	assert.Equal(t, "a", b.upserted[0].Id)
+	assert.Equal(t, "ns", b.lastNamespace)
+}
+
+func TestGatewayMapsBackendErrors(t *testing.T) {
+	b := &fakeBackend{err: &pinecone.PineconeError{Code: http.StatusNotFound, Msg: assert.AnError}}
+	h := newGateway(b, gatewayConfig{})
+
+	rec := do(h, http.MethodGet, "/indexes/missing", "")
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, "not_found", errorCode(t, rec))
+}
+
+func TestGatewayListIndexesTimeout(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{timeout: time.Minute})
+
+	start := time.Now()
+	rec := do(h, http.MethodGet, "/indexes", "")
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.WithinDuration(t, start.Add(time.Minute), b.listDeadline, 5*time.Second)
+}
+
+func TestGatewayCORS(t *testing.T) {
+	h := newGateway(&fakeBackend{}, gatewayConfig{allowOrigins: []string{"http://localhost:3000"}})
+
+	rec := do(h, http.MethodOptions, "/indexes/my-index/query", "",
+		"Origin", "http://localhost:3000", "Access-Control-Request-Method", "POST")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "http://localhost:3000", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, rec.Header().Get("Access-Control-Allow-Methods"), "POST")
+
+	rec = do(h, http.MethodGet, "/health", "", "Origin", "http://evil.example")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	rec = do(h, http.MethodOptions, "/indexes/my-index/upsert", "",
+		"Origin", "http://evil.example", "Access-Control-Request-Method", "POST")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Methods"))
+
+	wildcard := newGateway(&fakeBackend{}, gatewayConfig{allowOrigins: []string{"*"}})
+	rec = do(wildcard, http.MethodGet, "/health", "", "Origin", "http://any.example")
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func TestGatewayRefusesForeignOriginUpsert(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{allowOrigins: []string{"http://localhost:3000"}})
+	body := `{"vectors":[{"id":"a","values":[0.1]}]}`
+
+	// A "simple" cross-origin request, which browsers send without a preflight.
+	rec := do(h, http.MethodPost, "/indexes/my-index/upsert", body,
+		"Origin", "http://evil.example", "Content-Type", "text/plain")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "permission_denied", errorCode(t, rec))
+	assert.Nil(t, b.upserted)
+
+	rec = do(h, http.MethodPost, "/indexes/my-index/upsert", body, "Origin", "http://evil.example")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Nil(t, b.upserted)
+
+	rec = do(h, http.MethodPost, "/indexes/my-index/upsert", body, "Origin", "http://localhost:3000")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Len(t, b.upserted, 1)
+}
+
+func TestGatewayRequiresJSONBodies(t *testing.T) {
+	b := &fakeBackend{}
+	h := newGateway(b, gatewayConfig{})
+	body := `{"vectors":[{"id":"a","values":[0.1]}]}`
+
+	for _, contentType := range []string{"text/plain", "application/x-www-form-urlencoded", ""} {
+		rec := do(h, http.MethodPost, "/indexes/my-index/upsert", body, "Content-Type", contentType)
+		assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code, contentType)
+	}
+	assert.Nil(t, b.upserted)
+
+	rec := do(h, http.MethodPost, "/indexes/my-index/upsert", body, "Content-Type", "application/json; charset=utf-8")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestGatewayHostCheck(t *testing.T) {
+	h := newGateway(&fakeBackend{}, gatewayConfig{host: "127.0.0.1"})
+
+	for _, host := range []string{"127.0.0.1:8080", "localhost:8080", "[::1]:8080", "LOCALHOST"} {
+		assert.Equal(t, http.StatusOK, do(h, http.MethodGet, "/health", "", "Host", host).Code, host)
+	}
+	for _, host := range []string{"rebound.example:8080", "192.168.1.5:8080", ""} {
+		rec := do(h, http.MethodGet, "/health", "", "Host", host)
+		assert.Equal(t, http.StatusForbidden, rec.Code, host)
+	}
+
+	all := newGateway(&fakeBackend{}, gatewayConfig{host: "0.0.0.0"})
+	assert.Equal(t, http.StatusOK, do(all, http.MethodGet, "/health", "", "Host", "192.168.1.5:8080").Code)
+	assert.Equal(t, http.StatusForbidden, do(all, http.MethodGet, "/health", "", "Host", "rebound.example:8080").Code)
+
+	named := newGateway(&fakeBackend{}, gatewayConfig{host: "devbox.local"})
+	assert.Equal(t, http.StatusOK, do(named, http.MethodGet, "/health", "", "Host", "devbox.local:8080").Code)
+}
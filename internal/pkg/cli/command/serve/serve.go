@@ -0,0 +1,136 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/help"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/spf13/cobra"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+type ServeCmdOptions struct {
+	host         string
+	port         int
+	indexes      []string
+	readOnly     bool
+	allowOrigins []string
+}
+
+func NewServeCmd() *cobra.Command {
+	options := ServeCmdOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the target project over a local REST API",
+		Long: help.Long(`
+			Start a local HTTP gateway to the indexes in the target project, for
+			prototyping front-ends. Requests are made with the CLI's credentials, so
+			browser code never handles an API key.
+
+			Endpoints (request bodies take the same fields as the matching --body
+			payloads, plus an optional "namespace"):
+
+			  GET  /health
+			  GET  /indexes
+			  GET  /indexes/{index}
+			  GET  /indexes/{index}/stats
+			  POST /indexes/{index}/query
+			  POST /indexes/{index}/search
+			  POST /indexes/{index}/fetch
+			  POST /indexes/{index}/upsert            (not in --read-only mode)
+			  POST /indexes/{index}/records/upsert    (not in --read-only mode)
+
+			Errors use the same {"error": {...}} envelope as --json output.
+
+			The gateway listens on 127.0.0.1 by default. Use --index to limit which
+			indexes are served, and --allow-origin to let pages on other origins call
+			it; requests from browsers on any other origin are refused. POST bodies
+			must be sent with Content-Type: application/json, and the Host header
+			must name --host or a loopback address. Each request is subject to
+			--timeout; the server runs until interrupted.
+		`),
+		Example: help.Examples(`
+			pc serve --port 8080
+			pc serve --port 8080 --read-only --index my-index --allow-origin http://localhost:3000
+		`),
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+			if err := runServeCmd(cmd.Context(), options, timeout); err != nil {
+				msg.FailMsg("Gateway failed: %s", err)
+				exit.Error(err, "Gateway failed")
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&options.host, "host", "127.0.0.1", "address to listen on")
+	cmd.Flags().IntVarP(&options.port, "port", "p", 8080, "port to listen on")
+	cmd.Flags().StringArrayVar(&options.indexes, "index", nil, "serve only this index (repeatable); defaults to every index in the project")
+	cmd.Flags().BoolVar(&options.readOnly, "read-only", false, "refuse upserts")
+	cmd.Flags().StringArrayVar(&options.allowOrigins, "allow-origin", nil, "origin allowed to call the gateway from a browser, or '*' for any (repeatable)")
+
+	return cmd
+}
+
+func runServeCmd(ctx context.Context, options ServeCmdOptions, timeout time.Duration) error {
+	backend := newSDKBackend(sdk.NewPineconeClient(ctx))
+	defer backend.conns.Close()
+	handler := newGateway(backend, gatewayConfig{
+		indexes:      options.indexes,
+		readOnly:     options.readOnly,
+		allowOrigins: options.allowOrigins,
+		host:         options.host,
+		timeout:      timeout,
+	})
+
+	addr := net.JoinHostPort(options.host, strconv.Itoa(options.port))
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+
+	if ip := net.ParseIP(options.host); ip == nil || !ip.IsLoopback() {
+		msg.WarnMsg("The gateway is reachable from other machines on %s and uses your credentials", style.Emphasis(options.host))
+	}
+	mode := "read-write"
+	if options.readOnly {
+		mode = "read-only"
+	}
+	msg.SuccessMsg("Serving the target project at %s (%s); press Ctrl-C to stop", style.Emphasis(fmt.Sprintf("http://%s", listener.Addr())), mode)
+	log.Info().Str("addr", listener.Addr().String()).Bool("read_only", options.readOnly).Msg("Gateway started")
+
+	// Run until interrupted; the global --timeout applies to each request.
+	sigCtx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	errc := make(chan error, 1)
+	go func() { errc <- server.Serve(listener) }()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-sigCtx.Done():
+	}
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	msg.InfoMsg("Gateway stopped")
+	return nil
+}
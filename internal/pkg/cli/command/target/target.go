@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"golang.org/x/term"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
@@ -63,6 +64,23 @@ var (
 
 		--show and --clear are local-state operations and do not require
 		authentication.
+
+		PROJECT FILE
+
+		A .pinecone.yaml file in the current directory or any parent pins the
+		target for that directory tree without changing the global target:
+
+		  organization_id: "org-id"
+		  project_id: "project-id"
+		  index_name: "my-index"
+		  namespace: "my-namespace"
+		  environment: "production"
+
+		Pinned values take precedence over the global target, and index_name and
+		namespace are used as defaults for --index-name and --namespace of
+		commands that read or write data. Commands that delete or configure an
+		index, namespace or vectors never use them. --show reports where each
+		value came from.
 	`)
 
 	targetExample = help.Examples(`
@@ -167,8 +185,8 @@ func NewTargetCmd() *cobra.Command {
 			}
 			currentTokenOrgId := claims.OrgId
 
-			clientId := secrets.ClientId.Get()
-			clientSecret := secrets.ClientSecret.Get()
+			clientId := secrets.GetClientId()
+			clientSecret := secrets.GetClientSecret()
 			if token != nil && token.AccessToken == "" && clientId == "" && clientSecret == "" {
 				msg.FailJSON(options.json, "You must be logged in or have service account credentials configured to set a target context. Run %s to log in, or %s to configure credentials.", style.Code("pc login"), style.Code("pc auth configure"))
 				exit.ErrorMsg("You must be logged in or have service account credentials configured to set a target context")
@@ -226,6 +244,7 @@ func NewTargetCmd() *cobra.Command {
 					exit.ErrorMsg("failed to target a project")
 				} else {
 					msg.SuccessMsg("Target project set %s.", style.Emphasis(targetProject.Name))
+					warnIfPinnedByProjectFile(options.json)
 					return
 				}
 			}
@@ -302,6 +321,8 @@ func NewTargetCmd() *cobra.Command {
 				})
 			}
 
+			warnIfPinnedByProjectFile(options.json)
+
 			// Output JSON if the option was passed
 			if options.json {
 				printTargetContextJSON()
@@ -328,10 +349,21 @@ func NewTargetCmd() *cobra.Command {
 
 func printTargetContextJSON() {
 	targetContext := state.GetTargetContext()
-	targetContext.DefaultAPIKey = presenters.MaskHeadTail(secrets.DefaultAPIKey.Get(), 4, 4)
+	targetContext.DefaultAPIKey = presenters.MaskHeadTail(secrets.GetDefaultAPIKey(), 4, 4)
 	fmt.Fprintln(os.Stdout, text.IndentJSON(targetContext))
 }
 
+// warnIfPinnedByProjectFile tells the user when a project file overrides the
+// global target that was just set, since the effective target in this
+// directory does not change.
+func warnIfPinnedByProjectFile(jsonOutput bool) {
+	pf := projectfile.Load()
+	if jsonOutput || pf == nil || (pf.OrganizationId == "" && pf.ProjectId == "") {
+		return
+	}
+	msg.WarnMsg("The global target was updated, but %s pins the target for this directory and takes precedence.", style.Emphasis(pf.Path))
+}
+
 func validateTargetOptions(options targetCmdOptions) error {
 	// Check organization targeting
 	if options.org != "" && options.orgID != "" {
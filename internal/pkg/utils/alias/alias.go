@@ -0,0 +1,158 @@
+// Package alias expands user-defined command aliases, such as
+// "q" -> "index record search --index-name docs --inputs", before the command
+// line is parsed.
+package alias
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	validName   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+	placeholder = regexp.MustCompile(`\$[0-9]+`)
+)
+
+// reserved holds the names of built-in commands, which aliases can't shadow.
+var reserved = map[string]bool{}
+
+// Reserve marks names that aliases can't use, such as built-in commands.
+func Reserve(names ...string) {
+	for _, name := range names {
+		reserved[name] = true
+	}
+}
+
+// ValidateName checks that name can be typed as a command and doesn't shadow
+// a built-in one.
+func ValidateName(name string) error {
+	if !validName.MatchString(name) {
+		return fmt.Errorf("invalid alias name %q: use letters, digits, '-' and '_', starting with a letter or digit", name)
+	}
+	if reserved[name] {
+		return fmt.Errorf("%q is a built-in command and can't be used as an alias", name)
+	}
+	return nil
+}
+
+// Validate checks that expansion can be split into arguments.
+func Validate(expansion string) error {
+	words, err := Split(expansion)
+	if err != nil {
+		return err
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("alias expansion is empty")
+	}
+	return nil
+}
+
+// Expand returns the arguments that expansion stands for when the alias is
+// called with args. $1, $2, ... are replaced with the matching argument,
+// within a word or as a whole word, and a "$@" word is replaced with every
+// argument. Arguments not used by a placeholder are appended, unless the
+// expansion contains "$@". A leading "pc" in the expansion is dropped.
+func Expand(expansion string, args []string) ([]string, error) {
+	words, err := Split(expansion)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) > 0 && words[0] == "pc" {
+		words = words[1:]
+	}
+
+	used := make([]bool, len(args))
+	spliced := false
+	out := make([]string, 0, len(words)+len(args))
+	for _, word := range words {
+		if word == "$@" {
+			out = append(out, args...)
+			spliced = true
+			continue
+		}
+		var missing int
+		word = placeholder.ReplaceAllStringFunc(word, func(p string) string {
+			n, _ := strconv.Atoi(p[1:])
+			if n < 1 || n > len(args) {
+				if n > missing {
+					missing = n
+				}
+				return p
+			}
+			used[n-1] = true
+			return args[n-1]
+		})
+		if missing > 0 {
+			return nil, fmt.Errorf("alias needs at least %d argument(s), got %d", missing, len(args))
+		}
+		out = append(out, word)
+	}
+
+	if !spliced {
+		for i, arg := range args {
+			if !used[i] {
+				out = append(out, arg)
+			}
+		}
+	}
+	return out, nil
+}
+
+// Split breaks s into words the way a POSIX shell would, honouring single
+// quotes, double quotes and backslash escapes, without expanding anything.
+func Split(s string) ([]string, error) {
+	var (
+		words   []string
+		current strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inWord = true, true
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, current.String())
+				current.Reset()
+				inWord = false
+			}
+		default:
+			current.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in %q", quote, s)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash in %q", s)
+	}
+	if inWord {
+		words = append(words, current.String())
+	}
+	return words, nil
+}
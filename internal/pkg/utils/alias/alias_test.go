@@ -0,0 +1,79 @@
+package alias
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplit(t *testing.T) {
+	cases := map[string][]string{
+		`index stats --index-name docs`:           {"index", "stats", "--index-name", "docs"},
+		`  spaced   out  `:                        {"spaced", "out"},
+		`search --inputs '{"text": "a b"}'`:       {"search", "--inputs", `{"text": "a b"}`},
+		`say "double \"quoted\" words"`:           {"say", `double "quoted" words`},
+		`escaped\ space`:                          {"escaped space"},
+		`empty '' arg`:                            {"empty", "", "arg"},
+		`--filter='{"genre":"x"}' --namespace=$1`: {`--filter={"genre":"x"}`, "--namespace=$1"},
+		``: nil,
+	}
+	for in, want := range cases {
+		got, err := Split(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := Split(`unterminated 'quote`)
+	assert.Error(t, err)
+	_, err = Split(`trailing\`)
+	assert.Error(t, err)
+}
+
+func TestExpand_AppendsArguments(t *testing.T) {
+	got, err := Expand("index record search --index-name prod-docs --top-k 5 --inputs", []string{`{"text":"hi"}`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"index", "record", "search", "--index-name", "prod-docs", "--top-k", "5", "--inputs", `{"text":"hi"}`}, got)
+}
+
+func TestExpand_Placeholders(t *testing.T) {
+	got, err := Expand("index stats --index-name $1 --namespace=$2", []string{"docs", "en", "--json"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"index", "stats", "--index-name", "docs", "--namespace=en", "--json"}, got)
+}
+
+func TestExpand_AllArguments(t *testing.T) {
+	got, err := Expand("index vector fetch --index-name $1 --ids $@", []string{"docs", "a"})
+	require.NoError(t, err)
+	// $@ takes every argument, so nothing is appended
+	assert.Equal(t, []string{"index", "vector", "fetch", "--index-name", "docs", "--ids", "docs", "a"}, got)
+}
+
+func TestExpand_MissingArgument(t *testing.T) {
+	_, err := Expand("index stats --index-name $2", []string{"one"})
+	assert.ErrorContains(t, err, "at least 2")
+}
+
+func TestExpand_DropsLeadingPc(t *testing.T) {
+	got, err := Expand("pc index list", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"index", "list"}, got)
+}
+
+func TestValidateName(t *testing.T) {
+	Reserve("index")
+	t.Cleanup(func() { delete(reserved, "index") })
+
+	assert.NoError(t, ValidateName("q"))
+	assert.NoError(t, ValidateName("stats-of_2"))
+	assert.Error(t, ValidateName("index"))
+	assert.Error(t, ValidateName("-q"))
+	assert.Error(t, ValidateName("two words"))
+	assert.Error(t, ValidateName(""))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("index list"))
+	assert.Error(t, Validate("   "))
+	assert.Error(t, Validate("index 'open"))
+}
@@ -0,0 +1,42 @@
+// Package bulkimport checks and writes data in the storage layout read by
+// bulk imports: one directory per namespace under the import URI, holding
+// Parquet files with id, values, sparse_values and metadata columns.
+//
+// See https://docs.pinecone.io/guides/index-data/import-data.
+package bulkimport
+
+// Column names of an import Parquet file.
+const (
+	ColumnID           = "id"
+	ColumnValues       = "values"
+	ColumnSparseValues = "sparse_values"
+	ColumnMetadata     = "metadata"
+)
+
+// DefaultNamespaceDir is the directory of records imported into the default
+// namespace.
+const DefaultNamespaceDir = "__default__"
+
+// FileExt is the extension of the files an import reads.
+const FileExt = ".parquet"
+
+// Limits on records, matching those of upserts.
+const (
+	MaxIDLength     = 512
+	MaxMetadataSize = 40 * 1024
+)
+
+// Row is a record of an import Parquet file. Files for sparse indexes have
+// no values column.
+type Row struct {
+	ID           string        `parquet:"id"`
+	Values       []float32     `parquet:"values,list"`
+	SparseValues *SparseValues `parquet:"sparse_values,optional"`
+	Metadata     *string       `parquet:"metadata,optional"`
+}
+
+// SparseValues is the sparse_values column.
+type SparseValues struct {
+	Indices []uint32  `parquet:"indices,list"`
+	Values  []float32 `parquet:"values,list"`
+}
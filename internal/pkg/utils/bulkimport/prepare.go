@@ -0,0 +1,499 @@
+package bulkimport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// Defaults of PrepareOptions.
+const (
+	DefaultRowGroupSize = 10_000
+	DefaultRowsPerFile  = 1_000_000
+)
+
+// ManifestFile is the name of the manifest a Writer writes at the root of
+// the output. Imports ignore files outside namespace directories.
+const ManifestFile = "manifest.json"
+
+// SparseRow is a record of an import Parquet file for a sparse index.
+type SparseRow struct {
+	ID           string       `parquet:"id"`
+	SparseValues SparseValues `parquet:"sparse_values"`
+	Metadata     *string      `parquet:"metadata,optional"`
+}
+
+// NamespaceDir returns the directory of the records of namespace.
+func NamespaceDir(namespace string) string {
+	if namespace == "" {
+		return DefaultNamespaceDir
+	}
+	return namespace
+}
+
+// checkNamespace returns an error when namespace can't be a directory name
+// of the import layout.
+func checkNamespace(namespace string) error {
+	switch {
+	case namespace == "":
+		return nil
+	case namespace == "." || namespace == "..", strings.ContainsAny(namespace, `/\`):
+		return fmt.Errorf("namespace %q can't be used as a directory name", namespace)
+	case strings.HasPrefix(namespace, ".") || (strings.HasPrefix(namespace, "_") && namespace != DefaultNamespaceDir):
+		return fmt.Errorf("namespace %q starts with %q, which imports skip", namespace, namespace[:1])
+	}
+	return nil
+}
+
+// Source is a JSON or JSONL file of vectors for a namespace.
+type Source struct {
+	// Path is the file, or "-" for stdin.
+	Path      string
+	Namespace string
+}
+
+// Sources returns the files of input, a .json or .jsonl file, "-" for stdin,
+// or a directory. Files directly in a directory are for namespace; files in
+// its subdirectories are for the namespace named after the subdirectory,
+// with __default__ for the default namespace.
+func Sources(input, namespace string) ([]Source, error) {
+	if input == "-" {
+		return []Source{{Path: input, Namespace: namespace}}, checkNamespace(namespace)
+	}
+	info, err := os.Stat(input)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []Source{{Path: input, Namespace: namespace}}, checkNamespace(namespace)
+	}
+
+	entries, err := os.ReadDir(input)
+	if err != nil {
+		return nil, err
+	}
+	var sources []Source
+	for _, e := range entries {
+		p := filepath.Join(input, e.Name())
+		if !e.IsDir() {
+			if isVectorFile(e.Name()) {
+				sources = append(sources, Source{Path: p, Namespace: namespace})
+			}
+			continue
+		}
+		ns := e.Name()
+		if ns == DefaultNamespaceDir {
+			ns = ""
+		}
+		files, err := os.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if !f.IsDir() && isVectorFile(f.Name()) {
+				sources = append(sources, Source{Path: filepath.Join(p, f.Name()), Namespace: ns})
+			}
+		}
+	}
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no .json or .jsonl files found in %s", input)
+	}
+	for _, s := range sources {
+		if err := checkNamespace(s.Namespace); err != nil {
+			return nil, err
+		}
+	}
+	return sources, nil
+}
+
+func isVectorFile(name string) bool {
+	lower := strings.ToLower(name)
+	return !strings.HasPrefix(name, ".") && (strings.HasSuffix(lower, ".json") || strings.HasSuffix(lower, ".jsonl"))
+}
+
+// ReadVectors calls fn with each vector of r, in any of the formats of
+// pc index vector upsert: a JSONL stream of vectors, a JSON array of
+// vectors, or an object with a "vectors" array. Streams and arrays are
+// read one vector at a time.
+func ReadVectors(r io.Reader, fn func(v *pinecone.Vector) error) error {
+	br := bufio.NewReader(r)
+	first, err := peekNonSpace(br)
+	if err == io.EOF {
+		return errors.New("no vectors provided")
+	} else if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(br)
+	dec.DisallowUnknownFields()
+	n := 0
+	next := func() error {
+		n++
+		var v pinecone.Vector
+		if err := dec.Decode(&v); err != nil {
+			return fmt.Errorf("vector %d: %w", n, err)
+		}
+		if err := fn(&v); err != nil {
+			return fmt.Errorf("vector %d (id %q): %w", n, shorten(v.Id), err)
+		}
+		return nil
+	}
+
+	switch first {
+	case '[':
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+		for dec.More() {
+			if err := next(); err != nil {
+				return err
+			}
+		}
+		if _, err := dec.Token(); err != nil {
+			return err
+		}
+	case '{':
+		// Either the first vector of a stream, or {"vectors": [...]}.
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return fmt.Errorf("vector 1: %w", err)
+		}
+		var body struct {
+			Vectors []pinecone.Vector `json:"vectors"`
+		}
+		bodyDec := json.NewDecoder(bytes.NewReader(raw))
+		bodyDec.DisallowUnknownFields()
+		if bodyDec.Decode(&body) == nil && body.Vectors != nil {
+			for i := range body.Vectors {
+				n++
+				if err := fn(&body.Vectors[i]); err != nil {
+					return fmt.Errorf("vector %d (id %q): %w", n, shorten(body.Vectors[i].Id), err)
+				}
+			}
+			break
+		}
+		dec = json.NewDecoder(io.MultiReader(bytes.NewReader(raw), dec.Buffered(), br))
+		dec.DisallowUnknownFields()
+		for dec.More() {
+			if err := next(); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("expected a JSON object or array, found %q", first)
+	}
+
+	if n == 0 {
+		return errors.New("no vectors provided")
+	}
+	return nil
+}
+
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+			return b, br.UnreadByte()
+		}
+	}
+}
+
+// PrepareOptions describe the index and the files Writer writes.
+type PrepareOptions struct {
+	// Dimension is the index dimension. When zero, every dense vector must
+	// have as many values as the first one.
+	Dimension int
+	// VectorType is "dense" or "sparse". When empty, it's "dense" when the
+	// first vector has values, and "sparse" otherwise.
+	VectorType string
+	// RowGroupSize is the number of rows of each row group.
+	RowGroupSize int
+	// RowsPerFile is the number of rows after which a namespace continues in
+	// a new file.
+	RowsPerFile int
+}
+
+// Manifest describes the output of a Writer.
+type Manifest struct {
+	CreatedAt    time.Time           `json:"created_at"`
+	Sources      []string            `json:"sources"`
+	VectorType   string              `json:"vector_type"`
+	Dimension    int                 `json:"dimension,omitempty"`
+	RowGroupSize int                 `json:"row_group_size"`
+	Namespaces   []ManifestNamespace `json:"namespaces"`
+	Files        int                 `json:"files"`
+	Rows         int64               `json:"rows"`
+}
+
+// ManifestNamespace describes the files of a namespace.
+type ManifestNamespace struct {
+	// Name is the namespace, "" for the default namespace.
+	Name  string             `json:"name"`
+	Dir   string             `json:"dir"`
+	Rows  int64              `json:"rows"`
+	Files []ManifestDataFile `json:"files"`
+}
+
+// ManifestDataFile is a Parquet file, with a path relative to the output.
+type ManifestDataFile struct {
+	Path string `json:"path"`
+	Rows int64  `json:"rows"`
+	Size int64  `json:"size"`
+}
+
+// Writer writes vectors to Parquet files in the import layout, one
+// directory per namespace, and a manifest of the files once closed.
+type Writer struct {
+	dir string
+	// created is whether NewWriter created dir, so Abort removes it.
+	created    bool
+	options    PrepareOptions
+	validator  validator
+	sources    []string
+	namespaces map[string]*namespaceWriter
+}
+
+type namespaceWriter struct {
+	manifest ManifestNamespace
+	file     *os.File
+	dense    *parquet.GenericWriter[Row]
+	sparse   *parquet.GenericWriter[SparseRow]
+	rows     int64
+}
+
+// NewWriter returns a Writer to dir, which must be empty or not exist.
+func NewWriter(dir string, o PrepareOptions) (*Writer, error) {
+	switch o.VectorType {
+	case "", "dense", "sparse":
+	default:
+		return nil, fmt.Errorf("vector type must be dense or sparse, got %q", o.VectorType)
+	}
+	if o.RowGroupSize <= 0 {
+		o.RowGroupSize = DefaultRowGroupSize
+	}
+	if o.RowsPerFile <= 0 {
+		o.RowsPerFile = DefaultRowsPerFile
+	}
+
+	entries, err := os.ReadDir(dir)
+	created := false
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+		created = true
+	case err != nil:
+		return nil, err
+	case len(entries) > 0:
+		return nil, fmt.Errorf("%s is not empty", dir)
+	}
+
+	return &Writer{
+		dir:        dir,
+		created:    created,
+		options:    o,
+		validator:  validator{options: ValidateOptions{Dimension: o.Dimension, Sparse: o.VectorType == "sparse"}},
+		namespaces: map[string]*namespaceWriter{},
+	}, nil
+}
+
+// AddSource records a source of the vectors in the manifest.
+func (w *Writer) AddSource(source string) {
+	w.sources = append(w.sources, source)
+}
+
+// Write checks v the way Validate checks rows, and appends it to the files
+// of namespace.
+func (w *Writer) Write(namespace string, v *pinecone.Vector) error {
+	if w.options.VectorType == "" {
+		w.options.VectorType = "sparse"
+		if v.Values != nil && len(*v.Values) > 0 {
+			w.options.VectorType = "dense"
+		}
+		w.validator.options.Sparse = w.options.VectorType == "sparse"
+	}
+
+	row := Row{ID: v.Id}
+	if v.Values != nil {
+		row.Values = *v.Values
+	}
+	if v.SparseValues != nil {
+		row.SparseValues = &SparseValues{Indices: v.SparseValues.Indices, Values: v.SparseValues.Values}
+	}
+	if v.Metadata != nil {
+		b, err := json.Marshal(v.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata := string(b)
+		row.Metadata = &metadata
+	}
+	if msg := w.validator.checkRow(&row); msg != "" {
+		return errors.New(msg)
+	}
+	if w.validator.options.Sparse && len(row.Values) > 0 {
+		return errors.New("values aren't allowed in vectors for sparse indexes")
+	}
+
+	nw, err := w.namespace(namespace)
+	if err != nil {
+		return err
+	}
+	if nw.rows >= int64(w.options.RowsPerFile) {
+		if err := nw.closeFile(); err != nil {
+			return err
+		}
+	}
+	if nw.file == nil {
+		if err := w.openFile(nw); err != nil {
+			return err
+		}
+	}
+
+	if nw.dense != nil {
+		_, err = nw.dense.Write([]Row{row})
+	} else {
+		_, err = nw.sparse.Write([]SparseRow{{ID: row.ID, SparseValues: *row.SparseValues, Metadata: row.Metadata}})
+	}
+	if err != nil {
+		return err
+	}
+	nw.rows++
+	return nil
+}
+
+func (w *Writer) namespace(namespace string) (*namespaceWriter, error) {
+	if nw, ok := w.namespaces[namespace]; ok {
+		return nw, nil
+	}
+	if err := checkNamespace(namespace); err != nil {
+		return nil, err
+	}
+	dir := NamespaceDir(namespace)
+	if err := os.MkdirAll(filepath.Join(w.dir, dir), 0o755); err != nil {
+		return nil, err
+	}
+	nw := &namespaceWriter{manifest: ManifestNamespace{Name: namespace, Dir: dir, Files: []ManifestDataFile{}}}
+	w.namespaces[namespace] = nw
+	return nw, nil
+}
+
+func (w *Writer) openFile(nw *namespaceWriter) error {
+	name := fmt.Sprintf("part-%05d%s", len(nw.manifest.Files), FileExt)
+	p := nw.manifest.Dir + "/" + name
+	f, err := os.Create(filepath.Join(w.dir, nw.manifest.Dir, name))
+	if err != nil {
+		return err
+	}
+	options := []parquet.WriterOption{
+		parquet.Compression(&parquet.Snappy),
+		parquet.MaxRowsPerRowGroup(int64(w.options.RowGroupSize)),
+	}
+	if w.validator.options.Sparse {
+		nw.sparse = parquet.NewGenericWriter[SparseRow](f, options...)
+	} else {
+		nw.dense = parquet.NewGenericWriter[Row](f, options...)
+	}
+	nw.file = f
+	nw.rows = 0
+	nw.manifest.Files = append(nw.manifest.Files, ManifestDataFile{Path: p})
+	return nil
+}
+
+func (nw *namespaceWriter) closeFile() error {
+	if nw.file == nil {
+		return nil
+	}
+	var err error
+	if nw.dense != nil {
+		err = nw.dense.Close()
+	} else {
+		err = nw.sparse.Close()
+	}
+	if cerr := nw.file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(nw.file.Name())
+	if err != nil {
+		return err
+	}
+
+	last := &nw.manifest.Files[len(nw.manifest.Files)-1]
+	last.Rows = nw.rows
+	last.Size = info.Size()
+	nw.manifest.Rows += nw.rows
+	nw.file, nw.dense, nw.sparse = nil, nil, nil
+	return nil
+}
+
+// Close finishes the open files and writes the manifest, which it returns.
+func (w *Writer) Close() (*Manifest, error) {
+	m := &Manifest{
+		CreatedAt:    time.Now().UTC(),
+		Sources:      w.sources,
+		VectorType:   w.options.VectorType,
+		Dimension:    w.validator.dimension(),
+		RowGroupSize: w.options.RowGroupSize,
+		Namespaces:   []ManifestNamespace{},
+	}
+	if m.Sources == nil {
+		m.Sources = []string{}
+	}
+	for _, ns := range slices.Sorted(maps.Keys(w.namespaces)) {
+		nw := w.namespaces[ns]
+		if err := nw.closeFile(); err != nil {
+			return nil, err
+		}
+		m.Namespaces = append(m.Namespaces, nw.manifest)
+		m.Files += len(nw.manifest.Files)
+		m.Rows += nw.manifest.Rows
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(w.dir, ManifestFile), append(b, '\n'), 0o644); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Abort closes the open files without finishing them and removes what the
+// Writer wrote, so that a failed conversion can be run again into dir.
+func (w *Writer) Abort() error {
+	var errs []error
+	for _, nw := range w.namespaces {
+		if nw.file != nil {
+			errs = append(errs, nw.file.Close())
+			nw.file, nw.dense, nw.sparse = nil, nil, nil
+		}
+		errs = append(errs, os.RemoveAll(filepath.Join(w.dir, nw.manifest.Dir)))
+	}
+	if err := os.Remove(filepath.Join(w.dir, ManifestFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		errs = append(errs, err)
+	}
+	if w.created {
+		errs = append(errs, os.Remove(w.dir))
+	}
+	return errors.Join(errs...)
+}
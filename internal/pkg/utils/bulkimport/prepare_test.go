@@ -0,0 +1,218 @@
+package bulkimport
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readVectors(t *testing.T, input string) ([]*pinecone.Vector, error) {
+	t.Helper()
+	var out []*pinecone.Vector
+	err := ReadVectors(strings.NewReader(input), func(v *pinecone.Vector) error {
+		out = append(out, v)
+		return nil
+	})
+	return out, err
+}
+
+func ids(vectors []*pinecone.Vector) []string {
+	var out []string
+	for _, v := range vectors {
+		out = append(out, v.Id)
+	}
+	return out
+}
+
+func TestReadVectors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"jsonl", `{"id":"a","values":[1]}` + "\n" + `{"id":"b","values":[2]}` + "\n"},
+		{"array", ` [{"id":"a","values":[1]}, {"id":"b","values":[2]}]`},
+		{"body", `{"vectors":[{"id":"a","values":[1]},{"id":"b","values":[2]}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readVectors(t, tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"a", "b"}, ids(got))
+			assert.Equal(t, []float32{2}, *got[1].Values)
+		})
+	}
+
+	_, err := readVectors(t, "\n")
+	assert.EqualError(t, err, "no vectors provided")
+	_, err = readVectors(t, `{"vectors":[]}`)
+	assert.EqualError(t, err, "no vectors provided")
+	_, err = readVectors(t, `{"id":"a"}`+"\n"+`{"id":"b","vals":[1]}`)
+	assert.ErrorContains(t, err, `vector 2: json: unknown field "vals"`)
+	_, err = readVectors(t, `"a"`)
+	assert.EqualError(t, err, `expected a JSON object or array, found '"'`)
+}
+
+func TestSources(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "a.jsonl"), "")
+	writeFile(t, filepath.Join(dir, "notes.txt"), "")
+	writeFile(t, filepath.Join(dir, "__default__", "b.json"), "")
+	writeFile(t, filepath.Join(dir, "news", "c.JSONL"), "")
+	writeFile(t, filepath.Join(dir, "news", "deeper", "d.jsonl"), "")
+
+	got, err := Sources(dir, "docs")
+	require.NoError(t, err)
+	assert.Equal(t, []Source{
+		{Path: filepath.Join(dir, "__default__", "b.json"), Namespace: ""},
+		{Path: filepath.Join(dir, "a.jsonl"), Namespace: "docs"},
+		{Path: filepath.Join(dir, "news", "c.JSONL"), Namespace: "news"},
+	}, got)
+
+	got, err = Sources(filepath.Join(dir, "a.jsonl"), "")
+	require.NoError(t, err)
+	assert.Equal(t, []Source{{Path: filepath.Join(dir, "a.jsonl")}}, got)
+
+	_, err = Sources("-", "a/b")
+	assert.ErrorContains(t, err, "can't be used as a directory name")
+	_, err = Sources(filepath.Join(dir, "news", "deeper", "missing"), "")
+	assert.Error(t, err)
+	_, err = Sources(t.TempDir(), "")
+	assert.ErrorContains(t, err, "no .json or .jsonl files found")
+}
+
+func dense(id string, values ...float32) *pinecone.Vector {
+	return &pinecone.Vector{Id: id, Values: &values}
+}
+
+func TestWriter_Dense(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "import")
+	w, err := NewWriter(out, PrepareOptions{RowGroupSize: 2, RowsPerFile: 3})
+	require.NoError(t, err)
+	w.AddSource("vectors.jsonl")
+
+	metadata, err := json.Marshal(map[string]any{"genre": "rock"})
+	require.NoError(t, err)
+	withMetadata := dense("m", 1, 2)
+	withMetadata.Metadata = &pinecone.Metadata{}
+	require.NoError(t, json.Unmarshal(metadata, withMetadata.Metadata))
+
+	for i := range 4 {
+		require.NoError(t, w.Write("", dense(strings.Repeat("x", i+1), 1, 2)))
+	}
+	require.NoError(t, w.Write("news", withMetadata))
+	assert.EqualError(t, w.Write("news", dense("short", 1)), "1 values, but the first row has 2")
+	assert.EqualError(t, w.Write("news", &pinecone.Vector{Id: "none"}), "no values")
+	assert.EqualError(t, w.Write("a/b", dense("c", 1, 2)), `namespace "a/b" can't be used as a directory name`)
+
+	m, err := w.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "dense", m.VectorType)
+	assert.Equal(t, 2, m.Dimension)
+	assert.Equal(t, 3, m.Files)
+	assert.EqualValues(t, 5, m.Rows)
+	assert.Equal(t, []string{"vectors.jsonl"}, m.Sources)
+	require.Len(t, m.Namespaces, 2)
+	assert.Equal(t, DefaultNamespaceDir, m.Namespaces[0].Dir)
+	assert.Equal(t, "__default__/part-00000.parquet", m.Namespaces[0].Files[0].Path)
+	assert.EqualValues(t, 3, m.Namespaces[0].Files[0].Rows)
+	assert.EqualValues(t, 1, m.Namespaces[0].Files[1].Rows)
+	assert.Equal(t, "news", m.Namespaces[1].Name)
+
+	b, err := os.ReadFile(filepath.Join(out, ManifestFile))
+	require.NoError(t, err)
+	var written Manifest
+	require.NoError(t, json.Unmarshal(b, &written))
+	assert.Equal(t, m.Namespaces, written.Namespaces)
+
+	f, err := os.Open(filepath.Join(out, "__default__", "part-00000.parquet"))
+	require.NoError(t, err)
+	defer f.Close()
+	info, err := f.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), m.Namespaces[0].Files[0].Size)
+	pf, err := parquet.OpenFile(f, info.Size())
+	require.NoError(t, err)
+	assert.Len(t, pf.RowGroups(), 2)
+
+	rows, err := parquet.ReadFile[Row](filepath.Join(out, "news", "part-00000.parquet"))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.JSONEq(t, `{"genre":"rock"}`, *rows[0].Metadata)
+
+	r := validateDir(t, out, ValidateOptions{Dimension: 2})
+	assert.Empty(t, problems(r))
+	assert.EqualValues(t, 5, r.Rows)
+}
+
+func TestWriter_Sparse(t *testing.T) {
+	out := t.TempDir()
+	w, err := NewWriter(out, PrepareOptions{})
+	require.NoError(t, err)
+
+	sparse := &pinecone.Vector{Id: "a", SparseValues: &pinecone.SparseValues{Indices: []uint32{1, 5}, Values: []float32{0.5, 0.25}}}
+	require.NoError(t, w.Write("", sparse))
+	assert.EqualError(t, w.Write("", &pinecone.Vector{Id: "b"}), "no sparse_values")
+	assert.EqualError(t, w.Write("", &pinecone.Vector{Id: "c", Values: &[]float32{1}, SparseValues: sparse.SparseValues}),
+		"values aren't allowed in vectors for sparse indexes")
+
+	m, err := w.Close()
+	require.NoError(t, err)
+	assert.Equal(t, "sparse", m.VectorType)
+	assert.Zero(t, m.Dimension)
+
+	r := validateDir(t, out, ValidateOptions{Sparse: true})
+	assert.Empty(t, problems(r))
+	assert.EqualValues(t, 1, r.Rows)
+}
+
+func TestNewWriter(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "existing"), "")
+
+	_, err := NewWriter(dir, PrepareOptions{})
+	assert.ErrorContains(t, err, "is not empty")
+	_, err = NewWriter(t.TempDir(), PrepareOptions{VectorType: "hybrid"})
+	assert.ErrorContains(t, err, "vector type must be dense or sparse")
+}
+
+func TestWriter_AbortMidStream(t *testing.T) {
+	parent := t.TempDir()
+	out := filepath.Join(parent, "import")
+	w, err := NewWriter(out, PrepareOptions{RowsPerFile: 2})
+	require.NoError(t, err)
+
+	input := `{"id":"a","values":[1,2]}` + "\n" + `{"id":"b","values":[3,4]}` + "\n" +
+		`{"id":"c","values":[5,6]}` + "\n" + `{"id":"d","values":[7]}` + "\n"
+	err = ReadVectors(strings.NewReader(input), func(v *pinecone.Vector) error {
+		return w.Write("news", v)
+	})
+	require.EqualError(t, err, `vector 4 (id "d"): 1 values, but the first row has 2`)
+	require.FileExists(t, filepath.Join(out, "news", "part-00001.parquet"))
+
+	require.NoError(t, w.Abort())
+	assert.NoDirExists(t, out, "the output directory the writer created is removed")
+
+	// A directory that existed before is emptied, not removed, and can be
+	// written to again.
+	existing := t.TempDir()
+	w, err = NewWriter(existing, PrepareOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Write("", dense("a", 1)))
+	require.NoError(t, w.Abort())
+	entries, err := os.ReadDir(existing)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	w, err = NewWriter(existing, PrepareOptions{})
+	require.NoError(t, err)
+	require.NoError(t, w.Write("", dense("a", 1)))
+	_, err = w.Close()
+	require.NoError(t, err)
+}
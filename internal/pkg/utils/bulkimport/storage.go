@@ -0,0 +1,184 @@
+package bulkimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
+)
+
+// defaultS3Endpoint is used when neither --endpoint nor an AWS_ENDPOINT_URL
+// variable names an S3-compatible endpoint.
+const defaultS3Endpoint = "https://s3.amazonaws.com"
+
+// Object is a file under the root of a Storage.
+type Object struct {
+	// Path is relative to the root and separated by slashes.
+	Path string
+	Size int64
+}
+
+// File is an open Object.
+type File interface {
+	io.ReaderAt
+	io.Closer
+}
+
+// Storage is the location an import reads from, or a local mirror of it.
+type Storage interface {
+	// Location describes the root for messages, e.g. s3://bucket/prefix/.
+	Location() string
+	// List returns every object under the root.
+	List(ctx context.Context) ([]Object, error)
+	Open(ctx context.Context, path string) (File, error)
+}
+
+// OpenStorage returns the storage at uri: an s3:// URI, read through
+// endpoint or AWS S3 when endpoint is empty, or a local directory.
+func OpenStorage(uri, endpoint string) (Storage, error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme == "" || len(u.Scheme) == 1 {
+		// No scheme, or a Windows drive letter.
+		return newLocalStorage(uri)
+	}
+	switch u.Scheme {
+	case "file":
+		return newLocalStorage(u.Path)
+	case "s3":
+		return newS3Storage(u, endpoint)
+	default:
+		return nil, fmt.Errorf("unsupported URI scheme %q: validate an s3:// URI, or a local copy of the data", u.Scheme)
+	}
+}
+
+type localStorage struct {
+	root string
+}
+
+func newLocalStorage(root string) (*localStorage, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", root)
+	}
+	return &localStorage{root: root}, nil
+}
+
+func (s *localStorage) Location() string {
+	return s.root
+}
+
+func (s *localStorage) List(ctx context.Context) ([]Object, error) {
+	var objects []Object
+	err := filepath.WalkDir(s.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return ctx.Err()
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, Object{Path: filepath.ToSlash(rel), Size: info.Size()})
+		return nil
+	})
+	return objects, err
+}
+
+func (s *localStorage) Open(_ context.Context, p string) (File, error) {
+	return os.Open(filepath.Join(s.root, filepath.FromSlash(p)))
+}
+
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+// newS3Storage connects to the bucket of u with the credentials of the AWS
+// environment variables or shared credentials file. Without credentials,
+// requests are anonymous, which works for public buckets.
+func newS3Storage(u *url.URL, endpoint string) (*s3Storage, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid S3 URI %q: missing bucket name", u.String())
+	}
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_ENDPOINT_URL_S3")
+	}
+	if endpoint == "" {
+		endpoint = os.Getenv("AWS_ENDPOINT_URL")
+	}
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	e, err := url.Parse(endpoint)
+	if err != nil || e.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", endpoint)
+	}
+
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+	client, err := minio.New(e.Host, &minio.Options{
+		Creds: credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.FileAWSCredentials{},
+		}),
+		Secure:    e.Scheme == "https",
+		Region:    region,
+		Transport: transport.Default(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := strings.TrimPrefix(u.Path, "/")
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &s3Storage{client: client, bucket: u.Host, prefix: prefix}, nil
+}
+
+func (s *s3Storage) Location() string {
+	return "s3://" + path.Join(s.bucket, s.prefix) + "/"
+}
+
+func (s *s3Storage) List(ctx context.Context) ([]Object, error) {
+	var objects []Object
+	for info := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.prefix, Recursive: true}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		if strings.HasSuffix(info.Key, "/") {
+			// A folder placeholder created by a console.
+			continue
+		}
+		objects = append(objects, Object{Path: strings.TrimPrefix(info.Key, s.prefix), Size: info.Size})
+	}
+	return objects, nil
+}
+
+func (s *s3Storage) Open(ctx context.Context, p string) (File, error) {
+	return s.client.GetObject(ctx, s.bucket, s.prefix+p, minio.GetObjectOptions{})
+}
@@ -0,0 +1,67 @@
+package bulkimport
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidate_S3 validates data uploaded to an S3-compatible server, such as
+// MinIO started with:
+//
+//	minio server /tmp/minio-data
+//	PC_TEST_S3_ENDPOINT=http://localhost:9000 AWS_ACCESS_KEY_ID=minioadmin AWS_SECRET_ACCESS_KEY=minioadmin go test ./internal/pkg/utils/bulkimport/
+func TestValidate_S3(t *testing.T) {
+	endpoint := os.Getenv("PC_TEST_S3_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("set PC_TEST_S3_ENDPOINT to run against an S3-compatible server")
+	}
+	e, err := url.Parse(endpoint)
+	require.NoError(t, err)
+
+	client, err := minio.New(e.Host, &minio.Options{
+		Creds:  credentials.NewEnvAWS(),
+		Secure: e.Scheme == "https",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	bucket := fmt.Sprintf("pc-validate-%d", time.Now().UnixNano())
+	require.NoError(t, client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}))
+	t.Cleanup(func() {
+		for obj := range client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Recursive: true}) {
+			_ = client.RemoveObject(ctx, bucket, obj.Key, minio.RemoveObjectOptions{})
+		}
+		_ = client.RemoveBucket(ctx, bucket)
+	})
+
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "__default__", "part-0.parquet"), []Row{{ID: "a", Values: []float32{1, 2}}})
+	writeParquet(t, filepath.Join(dir, "news", "part-0.parquet"), []Row{{ID: "b", Values: []float32{1, 2, 3}}})
+	writeFile(t, filepath.Join(dir, "news", "readme.txt"), "")
+	for _, key := range []string{"__default__/part-0.parquet", "news/part-0.parquet", "news/readme.txt"} {
+		_, err := client.FPutObject(ctx, bucket, "data/"+key, filepath.Join(dir, filepath.FromSlash(key)), minio.PutObjectOptions{})
+		require.NoError(t, err)
+	}
+
+	s, err := OpenStorage("s3://"+bucket+"/data", endpoint)
+	require.NoError(t, err)
+	r, err := Validate(ctx, s, ValidateOptions{Dimension: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, "s3://"+bucket+"/data/", r.Location)
+	assert.Equal(t, []NamespaceSummary{{Name: "__default__", Files: 1, Rows: 1}, {Name: "news", Files: 1, Rows: 1}}, r.Namespaces)
+	assert.Equal(t, []string{
+		"warning news/readme.txt: ignored: not a .parquet file",
+		`error news/part-0.parquet: row 0 (id "b"): 3 values, but the index dimension is 2`,
+	}, problems(r))
+}
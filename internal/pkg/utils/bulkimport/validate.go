@@ -0,0 +1,402 @@
+package bulkimport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// Severity is how serious a Problem is. Errors fail the import or lose
+// records; warnings point out data the import ignores.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// maxRowProblems is how many problem rows of a file are reported one by
+// one before the rest are counted.
+const maxRowProblems = 3
+
+// Problem is something wrong with the data at Path, relative to the root.
+type Problem struct {
+	Severity Severity `json:"severity"`
+	Path     string   `json:"path,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// NamespaceSummary describes a namespace directory.
+type NamespaceSummary struct {
+	// Name is the directory name; records in __default__ go to the default
+	// namespace.
+	Name  string `json:"name"`
+	Files int    `json:"files"`
+	Rows  int64  `json:"rows"`
+}
+
+// Report is the result of Validate.
+type Report struct {
+	Location   string             `json:"location"`
+	Dimension  int                `json:"dimension,omitempty"`
+	Namespaces []NamespaceSummary `json:"namespaces"`
+	Files      int                `json:"files"`
+	Rows       int64              `json:"rows"`
+	// RowsChecked counts the rows read to check their values; the other
+	// rows are only counted.
+	RowsChecked int64     `json:"rows_checked"`
+	Problems    []Problem `json:"problems"`
+}
+
+// Count returns the number of problems with severity s.
+func (r *Report) Count(s Severity) int {
+	n := 0
+	for _, p := range r.Problems {
+		if p.Severity == s {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *Report) add(s Severity, p, format string, args ...any) {
+	r.Problems = append(r.Problems, Problem{Severity: s, Path: p, Message: fmt.Sprintf(format, args...)})
+}
+
+// ValidateOptions describe the index the data is for.
+type ValidateOptions struct {
+	// Dimension is the index dimension. When zero, every row must have as
+	// many values as the first one.
+	Dimension int
+	// Sparse is whether the index is sparse, so records have sparse_values
+	// and no values.
+	Sparse bool
+	// SampleRows is how many rows of each file are checked; 0 checks all.
+	SampleRows int
+}
+
+// Validate checks the layout of the objects in s and the schema and rows of
+// its Parquet files. It returns an error only when s can't be listed.
+func Validate(ctx context.Context, s Storage, o ValidateOptions) (*Report, error) {
+	objects, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Report{Location: s.Location(), Dimension: o.Dimension, Namespaces: []NamespaceSummary{}, Problems: []Problem{}}
+	files := map[string][]Object{}
+	for _, obj := range objects {
+		parts := strings.Split(obj.Path, "/")
+		name := parts[len(parts)-1]
+		isParquet := strings.HasSuffix(name, FileExt)
+		switch {
+		case strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_"):
+			// Hidden files and markers such as _SUCCESS.
+		case len(parts) == 1:
+			if isParquet {
+				r.add(SeverityWarning, obj.Path, "ignored: files must be in a namespace directory, e.g. %s/%s", DefaultNamespaceDir, name)
+			}
+		case len(parts) > 2:
+			if isParquet {
+				r.add(SeverityWarning, obj.Path, "ignored: files must be directly in a namespace directory, not in a subdirectory")
+			}
+		case !isParquet:
+			r.add(SeverityWarning, obj.Path, "ignored: not a %s file", FileExt)
+		default:
+			files[parts[0]] = append(files[parts[0]], obj)
+		}
+	}
+	if len(files) == 0 {
+		r.add(SeverityError, "", "no Parquet files found in namespace directories; put the files for each namespace in their own directory, e.g. %s/part-0%s", DefaultNamespaceDir, FileExt)
+		return r, nil
+	}
+
+	v := &validator{options: o, report: r}
+	for _, ns := range slices.Sorted(maps.Keys(files)) {
+		summary := NamespaceSummary{Name: ns}
+		seen := map[string]bool{}
+		for _, obj := range files[ns] {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			summary.Files++
+			summary.Rows += v.checkFile(ctx, s, obj, seen)
+		}
+		r.Namespaces = append(r.Namespaces, summary)
+		r.Files += summary.Files
+		r.Rows += summary.Rows
+	}
+	r.Dimension = v.dimension()
+	return r, nil
+}
+
+type validator struct {
+	options  ValidateOptions
+	report   *Report
+	inferred int
+}
+
+func (v *validator) dimension() int {
+	if v.options.Dimension > 0 {
+		return v.options.Dimension
+	}
+	return v.inferred
+}
+
+// checkFile checks the schema and rows of a Parquet file and returns its
+// row count. seen holds the IDs read so far in the namespace.
+func (v *validator) checkFile(ctx context.Context, s Storage, obj Object, seen map[string]bool) int64 {
+	r := v.report
+	f, err := s.Open(ctx, obj.Path)
+	if err != nil {
+		r.add(SeverityError, obj.Path, "can't open file: %s", err)
+		return 0
+	}
+	defer f.Close()
+
+	pf, err := parquet.OpenFile(f, obj.Size, parquet.SkipPageIndex(true), parquet.SkipBloomFilters(true))
+	if err != nil {
+		r.add(SeverityError, obj.Path, "not a readable Parquet file: %s", err)
+		return 0
+	}
+
+	errs, warnings := checkSchema(pf.Root(), v.options.Sparse)
+	for _, w := range warnings {
+		r.add(SeverityWarning, obj.Path, "%s", w)
+	}
+	for _, e := range errs {
+		r.add(SeverityError, obj.Path, "%s", e)
+	}
+	if len(errs) > 0 {
+		return pf.NumRows()
+	}
+
+	if err := v.checkRows(pf, obj.Path, seen); err != nil {
+		r.add(SeverityError, obj.Path, "can't read rows: %s", err)
+	}
+	return pf.NumRows()
+}
+
+func (v *validator) checkRows(pf *parquet.File, p string, seen map[string]bool) error {
+	reader := parquet.NewGenericReader[Row](pf)
+	defer reader.Close()
+
+	limit := pf.NumRows()
+	if v.options.SampleRows > 0 {
+		limit = min(limit, int64(v.options.SampleRows))
+	}
+
+	var problems, duplicates int
+	rows := make([]Row, 256)
+	for read := int64(0); read < limit; {
+		n, err := reader.Read(rows[:min(int64(len(rows)), limit-read)])
+		for i := range n {
+			row := &rows[i]
+			if msg := v.checkRow(row); msg != "" {
+				problems++
+				if problems <= maxRowProblems {
+					v.report.add(SeverityError, p, "row %d (id %q): %s", read+int64(i), shorten(row.ID), msg)
+				}
+			}
+			if seen[row.ID] {
+				duplicates++
+			}
+			seen[row.ID] = true
+		}
+		read += int64(n)
+		v.report.RowsChecked += int64(n)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if problems > maxRowProblems {
+		v.report.add(SeverityError, p, "%d more rows with problems", problems-maxRowProblems)
+	}
+	if duplicates > 0 {
+		v.report.add(SeverityWarning, p, "%d rows repeat an ID of the namespace; the last one read is kept", duplicates)
+	}
+	return nil
+}
+
+// shorten truncates a long ID for messages.
+func shorten(id string) string {
+	const max = 40
+	if len(id) <= max {
+		return id
+	}
+	return id[:max] + "..."
+}
+
+// checkRow returns what is wrong with row, or "" when it is valid.
+func (v *validator) checkRow(row *Row) string {
+	switch {
+	case row.ID == "":
+		return "empty id"
+	case len(row.ID) > MaxIDLength:
+		return fmt.Sprintf("id is longer than %d characters", MaxIDLength)
+	}
+
+	if !v.options.Sparse {
+		if len(row.Values) == 0 {
+			return "no values"
+		}
+		if v.dimension() == 0 {
+			v.inferred = len(row.Values)
+		}
+		if dim := v.dimension(); len(row.Values) != dim {
+			if v.options.Dimension > 0 {
+				return fmt.Sprintf("%d values, but the index dimension is %d", len(row.Values), dim)
+			}
+			return fmt.Sprintf("%d values, but the first row has %d", len(row.Values), dim)
+		}
+	}
+
+	if sv := row.SparseValues; sv != nil {
+		if len(sv.Indices) != len(sv.Values) {
+			return fmt.Sprintf("sparse_values has %d indices and %d values", len(sv.Indices), len(sv.Values))
+		}
+	}
+	if v.options.Sparse && (row.SparseValues == nil || len(row.SparseValues.Indices) == 0) {
+		return "no sparse_values"
+	}
+
+	if row.Metadata != nil && *row.Metadata != "" {
+		if len(*row.Metadata) > MaxMetadataSize {
+			return fmt.Sprintf("metadata is larger than %d bytes", MaxMetadataSize)
+		}
+		var obj map[string]any
+		if err := json.Unmarshal([]byte(*row.Metadata), &obj); err != nil {
+			return "metadata is not a JSON object"
+		}
+	}
+	return ""
+}
+
+// checkSchema returns the errors and warnings for the columns of root.
+func checkSchema(root *parquet.Column, sparse bool) (errs, warnings []string) {
+	columns := map[string]*parquet.Column{}
+	for _, c := range root.Columns() {
+		columns[c.Name()] = c
+	}
+
+	if c, ok := columns[ColumnID]; !ok {
+		errs = append(errs, "missing required column id (STRING)")
+	} else if !isString(c) {
+		errs = append(errs, fmt.Sprintf("column id must be a STRING, found %s", describeColumn(c)))
+	}
+
+	if c, ok := columns[ColumnValues]; sparse && ok {
+		warnings = append(warnings, "column values is ignored by sparse indexes")
+	} else if !sparse && !ok {
+		errs = append(errs, "missing required column values (LIST of FLOAT)")
+	} else if ok && !isList(c, parquet.Float) {
+		errs = append(errs, fmt.Sprintf("column values must be a LIST of FLOAT (float32), found %s", describeColumn(c)))
+	}
+
+	if c, ok := columns[ColumnSparseValues]; !ok {
+		if sparse {
+			errs = append(errs, "missing required column sparse_values (STRUCT of indices and values)")
+		}
+	} else if msg := checkSparseColumn(c); msg != "" {
+		errs = append(errs, msg)
+	}
+
+	if c, ok := columns[ColumnMetadata]; ok && !isString(c) {
+		errs = append(errs, fmt.Sprintf("column metadata must be a STRING of JSON, found %s", describeColumn(c)))
+	}
+
+	for _, c := range root.Columns() {
+		switch c.Name() {
+		case ColumnID, ColumnValues, ColumnSparseValues, ColumnMetadata:
+		default:
+			warnings = append(warnings, fmt.Sprintf("column %s is ignored; store extra fields in the metadata column", c.Name()))
+		}
+	}
+	return errs, warnings
+}
+
+func checkSparseColumn(c *parquet.Column) string {
+	want := "column sparse_values must be a STRUCT of indices (LIST of UINT32) and values (LIST of FLOAT)"
+	if c.Leaf() {
+		return fmt.Sprintf("%s, found %s", want, describeColumn(c))
+	}
+	indices, values := c.Column("indices"), c.Column("values")
+	if indices == nil || values == nil || len(c.Columns()) != 2 {
+		return fmt.Sprintf("%s, found fields %s", want, strings.Join(columnNames(c), ", "))
+	}
+	if !isList(indices, parquet.Int32) {
+		return fmt.Sprintf("%s, found indices %s", want, describeColumn(indices))
+	}
+	if !isList(values, parquet.Float) {
+		return fmt.Sprintf("%s, found values %s", want, describeColumn(values))
+	}
+	return ""
+}
+
+func isString(c *parquet.Column) bool {
+	if !c.Leaf() || c.Type().Kind() != parquet.ByteArray {
+		return false
+	}
+	lt := c.Type().LogicalType()
+	return lt != nil && (lt.UTF8 != nil || lt.Json != nil)
+}
+
+func isList(c *parquet.Column, kind parquet.Kind) bool {
+	e := listElement(c)
+	return e != nil && e.Type().Kind() == kind
+}
+
+// listElement returns the element column of a LIST column, in the standard
+// three-level layout or the legacy two-level one.
+func listElement(c *parquet.Column) *parquet.Column {
+	if c.Leaf() {
+		return nil
+	}
+	if lt := c.Type().LogicalType(); lt == nil || lt.List == nil {
+		return nil
+	}
+	if len(c.Columns()) != 1 {
+		return nil
+	}
+	repeated := c.Columns()[0]
+	if repeated.Leaf() {
+		return repeated
+	}
+	if len(repeated.Columns()) == 1 && repeated.Columns()[0].Leaf() {
+		return repeated.Columns()[0]
+	}
+	return nil
+}
+
+// describeColumn names the type of c for messages, e.g. LIST<DOUBLE>.
+func describeColumn(c *parquet.Column) string {
+	if c.Leaf() {
+		if lt := c.Type().LogicalType(); lt != nil {
+			return fmt.Sprintf("%s (%s)", c.Type().Kind(), lt)
+		}
+		return c.Type().Kind().String()
+	}
+	if e := listElement(c); e != nil {
+		return fmt.Sprintf("LIST<%s>", describeColumn(e))
+	}
+	return fmt.Sprintf("STRUCT<%s>", strings.Join(columnNames(c), ", "))
+}
+
+func columnNames(c *parquet.Column) []string {
+	names := make([]string, 0, len(c.Columns()))
+	for _, child := range c.Columns() {
+		names = append(names, child.Name())
+	}
+	return names
+}
@@ -0,0 +1,230 @@
+package bulkimport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeParquet[T any](t *testing.T, path string, rows []T) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := parquet.NewGenericWriter[T](f)
+	_, err = w.Write(rows)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func validateDir(t *testing.T, dir string, o ValidateOptions) *Report {
+	t.Helper()
+	s, err := OpenStorage(dir, "")
+	require.NoError(t, err)
+	r, err := Validate(context.Background(), s, o)
+	require.NoError(t, err)
+	return r
+}
+
+func problems(r *Report) []string {
+	var out []string
+	for _, p := range r.Problems {
+		out = append(out, string(p.Severity)+" "+p.Path+": "+p.Message)
+	}
+	return out
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestValidate_ValidDense(t *testing.T) {
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "__default__", "part-0.parquet"), []Row{
+		{ID: "a", Values: []float32{1, 2, 3}, Metadata: ptr(`{"genre":"rock"}`)},
+		{ID: "b", Values: []float32{4, 5, 6}, SparseValues: &SparseValues{Indices: []uint32{1, 7}, Values: []float32{0.5, 0.25}}},
+	})
+	writeParquet(t, filepath.Join(dir, "news", "part-0.parquet"), []Row{{ID: "c", Values: []float32{7, 8, 9}}})
+	writeFile(t, filepath.Join(dir, "manifest.json"), "{}")
+	writeFile(t, filepath.Join(dir, "news", "_SUCCESS"), "")
+
+	r := validateDir(t, dir, ValidateOptions{Dimension: 3})
+
+	assert.Empty(t, problems(r))
+	assert.Equal(t, []NamespaceSummary{{Name: "__default__", Files: 1, Rows: 2}, {Name: "news", Files: 1, Rows: 1}}, r.Namespaces)
+	assert.Equal(t, 2, r.Files)
+	assert.EqualValues(t, 3, r.Rows)
+	assert.EqualValues(t, 3, r.RowsChecked)
+	assert.Equal(t, 3, r.Dimension)
+}
+
+func TestValidate_Layout(t *testing.T) {
+	dir := t.TempDir()
+	rows := []Row{{ID: "a", Values: []float32{1}}}
+	writeParquet(t, filepath.Join(dir, "top.parquet"), rows)
+	writeParquet(t, filepath.Join(dir, "ns", "nested", "deep.parquet"), rows)
+	writeFile(t, filepath.Join(dir, "ns", "notes.csv"), "id\n")
+
+	r := validateDir(t, dir, ValidateOptions{})
+
+	assert.Equal(t, []string{
+		"warning ns/nested/deep.parquet: ignored: files must be directly in a namespace directory, not in a subdirectory",
+		"warning ns/notes.csv: ignored: not a .parquet file",
+		"warning top.parquet: ignored: files must be in a namespace directory, e.g. __default__/top.parquet",
+		"error : no Parquet files found in namespace directories; put the files for each namespace in their own directory, e.g. __default__/part-0.parquet",
+	}, problems(r))
+}
+
+func TestValidate_Schema(t *testing.T) {
+	type doubleValues struct {
+		ID     string    `parquet:"id"`
+		Values []float64 `parquet:"values,list"`
+		Source string    `parquet:"source"`
+	}
+	type noID struct {
+		Key    string    `parquet:"key"`
+		Values []float32 `parquet:"values,list"`
+	}
+	type intMetadata struct {
+		ID       string    `parquet:"id"`
+		Values   []float32 `parquet:"values,list"`
+		Metadata int64     `parquet:"metadata"`
+	}
+
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "a", "double.parquet"), []doubleValues{{ID: "a", Values: []float64{1}}})
+	writeParquet(t, filepath.Join(dir, "b", "noid.parquet"), []noID{{Key: "a", Values: []float32{1}}})
+	writeParquet(t, filepath.Join(dir, "c", "meta.parquet"), []intMetadata{{ID: "a", Values: []float32{1}}})
+	writeFile(t, filepath.Join(dir, "d", "broken.parquet"), "not parquet")
+
+	r := validateDir(t, dir, ValidateOptions{})
+
+	got := problems(r)
+	require.Len(t, got, 6)
+	assert.Equal(t, "warning a/double.parquet: column source is ignored; store extra fields in the metadata column", got[0])
+	assert.Equal(t, "error a/double.parquet: column values must be a LIST of FLOAT (float32), found LIST<DOUBLE>", got[1])
+	assert.Equal(t, "warning b/noid.parquet: column key is ignored; store extra fields in the metadata column", got[2])
+	assert.Equal(t, "error b/noid.parquet: missing required column id (STRING)", got[3])
+	assert.Equal(t, "error c/meta.parquet: column metadata must be a STRING of JSON, found INT64 (INT(64,true))", got[4])
+	assert.True(t, strings.HasPrefix(got[5], "error d/broken.parquet: not a readable Parquet file"), got[5])
+	assert.Zero(t, r.RowsChecked, "rows of files with schema errors aren't read")
+}
+
+func TestValidate_Rows(t *testing.T) {
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "ns", "part-0.parquet"), []Row{
+		{ID: "ok", Values: []float32{1, 2}},
+		{ID: "", Values: []float32{1, 2}},
+		{ID: "short", Values: []float32{1}},
+		{ID: "meta", Values: []float32{1, 2}, Metadata: ptr(`["not", "an", "object"]`)},
+		{ID: "sparse", Values: []float32{1, 2}, SparseValues: &SparseValues{Indices: []uint32{1}, Values: []float32{}}},
+		{ID: "long", Values: []float32{1, 2}, Metadata: ptr(`{"text":"` + strings.Repeat("x", MaxMetadataSize) + `"}`)},
+		{ID: "ok", Values: []float32{3, 4}},
+	})
+
+	r := validateDir(t, dir, ValidateOptions{})
+
+	assert.Equal(t, []string{
+		`error ns/part-0.parquet: row 1 (id ""): empty id`,
+		`error ns/part-0.parquet: row 2 (id "short"): 1 values, but the first row has 2`,
+		`error ns/part-0.parquet: row 3 (id "meta"): metadata is not a JSON object`,
+		`error ns/part-0.parquet: 2 more rows with problems`,
+		`warning ns/part-0.parquet: 1 rows repeat an ID of the namespace; the last one read is kept`,
+	}, problems(r))
+	assert.Equal(t, 2, r.Dimension)
+}
+
+func TestValidate_IndexDimension(t *testing.T) {
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "__default__", "part-0.parquet"), []Row{{ID: "a", Values: []float32{1, 2}}})
+
+	r := validateDir(t, dir, ValidateOptions{Dimension: 3})
+
+	assert.Equal(t, []string{`error __default__/part-0.parquet: row 0 (id "a"): 2 values, but the index dimension is 3`}, problems(r))
+}
+
+func TestValidate_Sparse(t *testing.T) {
+	type sparseRow struct {
+		ID           string        `parquet:"id"`
+		SparseValues *SparseValues `parquet:"sparse_values,optional"`
+	}
+
+	dir := t.TempDir()
+	writeParquet(t, filepath.Join(dir, "ok", "part-0.parquet"), []sparseRow{
+		{ID: "a", SparseValues: &SparseValues{Indices: []uint32{3}, Values: []float32{0.5}}},
+		{ID: "b"},
+	})
+	writeParquet(t, filepath.Join(dir, "dense", "part-0.parquet"), []Row{{ID: "a", Values: []float32{1}}})
+	type missing struct {
+		ID string `parquet:"id"`
+	}
+	writeParquet(t, filepath.Join(dir, "missing", "part-0.parquet"), []missing{{ID: "a"}})
+
+	r := validateDir(t, dir, ValidateOptions{Sparse: true})
+
+	assert.Equal(t, []string{
+		`warning dense/part-0.parquet: column values is ignored by sparse indexes`,
+		`error dense/part-0.parquet: row 0 (id "a"): no sparse_values`,
+		`error missing/part-0.parquet: missing required column sparse_values (STRUCT of indices and values)`,
+		`error ok/part-0.parquet: row 1 (id "b"): no sparse_values`,
+	}, problems(r))
+}
+
+func TestValidate_SampleRows(t *testing.T) {
+	dir := t.TempDir()
+	rows := make([]Row, 600)
+	for i := range rows {
+		rows[i] = Row{ID: strings.Repeat("x", i+1), Values: []float32{1}}
+	}
+	writeParquet(t, filepath.Join(dir, "ns", "part-0.parquet"), rows)
+
+	r := validateDir(t, dir, ValidateOptions{SampleRows: 300})
+	assert.EqualValues(t, 600, r.Rows)
+	assert.EqualValues(t, 300, r.RowsChecked)
+	assert.Empty(t, problems(r))
+
+	r = validateDir(t, dir, ValidateOptions{})
+	assert.EqualValues(t, 600, r.RowsChecked)
+	assert.Equal(t, []string{
+		`error ns/part-0.parquet: row 512 (id "` + strings.Repeat("x", 40) + `..."): id is longer than 512 characters`,
+		`error ns/part-0.parquet: row 513 (id "` + strings.Repeat("x", 40) + `..."): id is longer than 512 characters`,
+		`error ns/part-0.parquet: row 514 (id "` + strings.Repeat("x", 40) + `..."): id is longer than 512 characters`,
+		`error ns/part-0.parquet: 85 more rows with problems`,
+	}, problems(r))
+}
+
+func TestOpenStorage(t *testing.T) {
+	dir := t.TempDir()
+
+	s, err := OpenStorage(dir, "")
+	require.NoError(t, err)
+	assert.Equal(t, dir, s.Location())
+
+	s, err = OpenStorage("file://"+dir, "")
+	require.NoError(t, err)
+	assert.Equal(t, dir, s.Location())
+
+	s, err = OpenStorage("s3://bucket/data", "http://localhost:9000")
+	require.NoError(t, err)
+	assert.Equal(t, "s3://bucket/data/", s.Location())
+
+	_, err = OpenStorage("gs://bucket/data/", "")
+	assert.ErrorContains(t, err, `unsupported URI scheme "gs"`)
+
+	_, err = OpenStorage("s3:///data/", "")
+	assert.ErrorContains(t, err, "missing bucket name")
+
+	_, err = OpenStorage(filepath.Join(dir, "missing"), "")
+	assert.Error(t, err)
+}
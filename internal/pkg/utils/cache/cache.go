@@ -0,0 +1,161 @@
+// Package cache stores short-lived API results on disk, so that commands such
+// as shell completion stay fast and keep working from the last known values
+// when the API can't be reached.
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+)
+
+// maxAge is how long an entry is kept as a fallback after it goes stale.
+const maxAge = 7 * 24 * time.Hour
+
+var disabled bool
+
+// Disable makes every store miss on reads, as with --no-cache. Fetched values
+// are still written so that later commands can use them.
+func Disable() {
+	disabled = true
+}
+
+// Dir returns the directory holding the CLI's cache files.
+func Dir() string {
+	return filepath.Join(configuration.ConfigDirPath(), "cache")
+}
+
+// Clear removes every cache file.
+func Clear() error {
+	return os.RemoveAll(Dir())
+}
+
+type entry struct {
+	StoredAt time.Time       `json:"stored_at"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// Store is a JSON file of keyed values that are fresh for TTL after they are
+// written. Stale values stay readable until they are a week old.
+type Store struct {
+	path string
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu sync.Mutex
+}
+
+// Open returns the store saved as name.json in Dir.
+func Open(name string, ttl time.Duration) *Store {
+	return NewStore(filepath.Join(Dir(), name+".json"), ttl)
+}
+
+// NewStore returns a store saved at path.
+func NewStore(path string, ttl time.Duration) *Store {
+	return &Store{path: path, ttl: ttl, now: time.Now}
+}
+
+// Get decodes the value stored under key into v. ok reports whether a value
+// was found, and fresh whether it is younger than the store's TTL.
+func (s *Store) Get(key string, v any) (fresh, ok bool) {
+	if disabled {
+		return false, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, found := s.read()[key]
+	if !found {
+		return false, false
+	}
+	if err := json.Unmarshal(e.Value, v); err != nil {
+		log.Debug().Err(err).Str("key", key).Msg("Ignoring unreadable cache entry")
+		return false, false
+	}
+	return s.now().Sub(e.StoredAt) < s.ttl, true
+}
+
+// Put stores v under key and drops entries older than the fallback age.
+func (s *Store) Put(key string, v any) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.read()
+	now := s.now()
+	for k, e := range entries {
+		if now.Sub(e.StoredAt) > maxAge {
+			delete(entries, k)
+		}
+	}
+	entries[key] = entry{StoredAt: now, Value: value}
+	return s.write(entries)
+}
+
+// Delete removes the entries whose keys match.
+func (s *Store) Delete(match func(key string) bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := s.read()
+	for k := range entries {
+		if match(k) {
+			delete(entries, k)
+		}
+	}
+	return s.write(entries)
+}
+
+func (s *Store) read() map[string]entry {
+	entries := map[string]entry{}
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			log.Debug().Err(err).Str("path", s.path).Msg("Error reading cache file")
+		}
+		return entries
+	}
+	if err := json.Unmarshal(b, &entries); err != nil {
+		// A corrupt cache is only a missed speedup; start over
+		log.Debug().Err(err).Str("path", s.path).Msg("Ignoring corrupt cache file")
+		return map[string]entry{}
+	}
+	return entries
+}
+
+// write replaces the file atomically so concurrent pc processes never read a
+// partial file; the last writer wins.
+func (s *Store) write(entries map[string]entry) error {
+	b, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,112 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestStore(t *testing.T, ttl time.Duration) (*Store, *time.Time) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewStore(filepath.Join(t.TempDir(), "test.json"), ttl)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestStore_GetFreshAndStale(t *testing.T) {
+	s, now := newTestStore(t, time.Minute)
+	if err := s.Put("indexes", []string{"a", "b"}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	var got []string
+	fresh, ok := s.Get("indexes", &got)
+	if !ok || !fresh || len(got) != 2 {
+		t.Fatalf("Get = %v fresh=%v ok=%v, want fresh hit", got, fresh, ok)
+	}
+
+	*now = now.Add(2 * time.Minute)
+	got = nil
+	fresh, ok = s.Get("indexes", &got)
+	if !ok || fresh || len(got) != 2 {
+		t.Fatalf("Get = %v fresh=%v ok=%v, want stale hit", got, fresh, ok)
+	}
+}
+
+func TestStore_Missing(t *testing.T) {
+	s, _ := newTestStore(t, time.Minute)
+	var got []string
+	if _, ok := s.Get("nope", &got); ok {
+		t.Fatal("expected miss")
+	}
+}
+
+func TestStore_PutDropsExpiredEntries(t *testing.T) {
+	s, now := newTestStore(t, time.Minute)
+	_ = s.Put("old", 1)
+	*now = now.Add(maxAge + time.Hour)
+	_ = s.Put("new", 2)
+
+	var v int
+	if _, ok := s.Get("old", &v); ok {
+		t.Fatal("expected old entry to be dropped")
+	}
+	if _, ok := s.Get("new", &v); !ok || v != 2 {
+		t.Fatalf("new = %d ok=%v", v, ok)
+	}
+}
+
+func TestStore_Delete(t *testing.T) {
+	s, _ := newTestStore(t, time.Minute)
+	_ = s.Put("prod/p1/indexes", 1)
+	_ = s.Put("prod/p2/indexes", 2)
+	if err := s.Delete(func(k string) bool { return strings.HasPrefix(k, "prod/p1/") }); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	var v int
+	if _, ok := s.Get("prod/p1/indexes", &v); ok {
+		t.Fatal("expected p1 entry to be deleted")
+	}
+	if _, ok := s.Get("prod/p2/indexes", &v); !ok {
+		t.Fatal("expected p2 entry to remain")
+	}
+}
+
+func TestStore_CorruptFileIsIgnored(t *testing.T) {
+	s, _ := newTestStore(t, time.Minute)
+	if err := os.WriteFile(s.path, []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var v int
+	if _, ok := s.Get("k", &v); ok {
+		t.Fatal("expected miss on corrupt file")
+	}
+	if err := s.Put("k", 1); err != nil {
+		t.Fatalf("Put after corrupt file: %v", err)
+	}
+	if _, ok := s.Get("k", &v); !ok || v != 1 {
+		t.Fatalf("k = %d ok=%v", v, ok)
+	}
+}
+
+func TestStore_DisabledMissesButStillWrites(t *testing.T) {
+	s, _ := newTestStore(t, time.Minute)
+	Disable()
+	t.Cleanup(func() { disabled = false })
+
+	if err := s.Put("k", 1); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	var v int
+	if _, ok := s.Get("k", &v); ok {
+		t.Fatal("expected miss while disabled")
+	}
+
+	disabled = false
+	if _, ok := s.Get("k", &v); !ok || v != 1 {
+		t.Fatalf("k = %d ok=%v, want value written while disabled", v, ok)
+	}
+}
@@ -0,0 +1,347 @@
+// Package clierr classifies errors into the stable codes and exit statuses
+// the CLI reports to scripts. Errors from the Pinecone SDK, gRPC, OAuth and
+// the network are mapped here, in one place, so every command reports the
+// same code for the same failure.
+package clierr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Code is the machine-readable kind of an error, reported as error.code in
+// JSON output.
+type Code string
+
+const (
+	CodeUsage            Code = "usage_error"
+	CodeInvalidArgument  Code = "invalid_argument"
+	CodeUnauthenticated  Code = "unauthenticated"
+	CodePermissionDenied Code = "permission_denied"
+	CodeNotFound         Code = "not_found"
+	CodeConflict         Code = "conflict"
+	CodeRateLimited      Code = "rate_limited"
+	CodeTimeout          Code = "timeout"
+	CodePartialFailure   Code = "partial_failure"
+	CodeUnavailable      Code = "unavailable"
+	CodeCanceled         Code = "canceled"
+	CodeInternal         Code = "internal_error"
+	CodeUnknown          Code = "error"
+)
+
+// Process exit codes. They are part of the CLI's public interface and must not
+// change.
+const (
+	ExitGeneric        = 1
+	ExitUsage          = 2
+	ExitAuth           = 3
+	ExitNotFound       = 4
+	ExitConflict       = 5
+	ExitRateLimited    = 6
+	ExitTimeout        = 7
+	ExitPartialFailure = 8
+	ExitCanceled       = 130
+)
+
+// ExitCode returns the process exit code for c.
+func (c Code) ExitCode() int {
+	switch c {
+	case CodeUsage, CodeInvalidArgument:
+		return ExitUsage
+	case CodeUnauthenticated, CodePermissionDenied:
+		return ExitAuth
+	case CodeNotFound:
+		return ExitNotFound
+	case CodeConflict:
+		return ExitConflict
+	case CodeRateLimited:
+		return ExitRateLimited
+	case CodeTimeout:
+		return ExitTimeout
+	case CodePartialFailure:
+		return ExitPartialFailure
+	case CodeCanceled:
+		return ExitCanceled
+	default:
+		return ExitGeneric
+	}
+}
+
+// Error is a classified error. Commands can return one directly, e.g. with
+// New(CodeUsage, ...), to choose the code themselves; otherwise Classify
+// derives it from the underlying error.
+type Error struct {
+	Code       Code
+	Message    string
+	HTTPStatus int
+	RequestID  string
+	Retryable  bool
+	Err        error
+}
+
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return string(e.Code)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the process exit code for e.
+func (e *Error) ExitCode() int {
+	return e.Code.ExitCode()
+}
+
+// MarshalJSON renders the body of the {"error": {...}} envelope. Every key is
+// always present; http_status and request_id are null when unknown.
+func (e *Error) MarshalJSON() ([]byte, error) {
+	body := struct {
+		Code       Code    `json:"code"`
+		Message    string  `json:"message"`
+		HTTPStatus *int    `json:"http_status"`
+		RequestID  *string `json:"request_id"`
+		Retryable  bool    `json:"retryable"`
+	}{Code: e.Code, Message: e.Error(), Retryable: e.Retryable}
+	if e.HTTPStatus != 0 {
+		body.HTTPStatus = &e.HTTPStatus
+	}
+	if e.RequestID != "" {
+		body.RequestID = &e.RequestID
+	}
+	return json.Marshal(body)
+}
+
+// Envelope is the JSON document printed for a failed command in --json mode.
+type Envelope struct {
+	Error *Error `json:"error"`
+}
+
+// New returns an error with an explicit code.
+func New(code Code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), Retryable: retryable(code)}
+}
+
+// Wrap returns err classified with an explicit code. The message is err's.
+func Wrap(code Code, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Code: code, Err: err, Retryable: retryable(code)}
+}
+
+// Partial reclassifies err as a partial failure: some of the work, such as
+// earlier upsert batches, succeeded before err. HTTP status, request ID and
+// retryability of err are kept.
+func Partial(err error) error {
+	if err == nil {
+		return nil
+	}
+	c := Classify(err)
+	c.Code = CodePartialFailure
+	return c
+}
+
+// ExitCodeFor returns the process exit code for err: ExitGeneric for errors
+// that can't be classified, and 0 for nil.
+func ExitCodeFor(err error) int {
+	if err == nil {
+		return 0
+	}
+	return Classify(err).ExitCode()
+}
+
+// Classifier is implemented by errors from CLI packages that know their own
+// classification, such as OAuth token errors.
+type Classifier interface {
+	ClassifyError() *Error
+}
+
+// Classify returns the classification of err. The message is err's message;
+// HTTP status and request ID are filled in when known. It returns nil for a
+// nil error.
+func Classify(err error) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var ce *Error
+	if errors.As(err, &ce) {
+		out := *ce
+		out.Message = err.Error()
+		out.Err = err
+		return &out
+	}
+
+	out := &Error{Code: CodeUnknown, Message: err.Error(), Err: err}
+	var classifier Classifier
+	var pe *pinecone.PineconeError
+	var netErr net.Error
+	var urlErr *url.Error
+	switch {
+	case errors.As(err, &classifier):
+		if c := classifier.ClassifyError(); c != nil {
+			out.Code, out.HTTPStatus, out.Retryable = c.Code, c.HTTPStatus, c.Retryable
+		}
+	case errors.As(err, &pe):
+		out.HTTPStatus = pe.Code
+		out.Code = codeForHTTPStatus(pe.Code)
+		out.Retryable = retryableHTTPStatus(pe.Code)
+		out.RequestID = LastRequestID()
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, os.ErrDeadlineExceeded):
+		out.Code, out.Retryable = CodeTimeout, true
+	case errors.Is(err, context.Canceled):
+		out.Code = CodeCanceled
+	case isGRPCStatus(err):
+		st, _ := status.FromError(err)
+		out.Code = codeForGRPC(st.Code())
+		out.Retryable = retryableGRPC(st.Code())
+	case errors.As(err, &netErr) && netErr.Timeout():
+		out.Code, out.Retryable = CodeTimeout, true
+	case errors.As(err, &urlErr), errors.As(err, &netErr):
+		out.Code, out.Retryable = CodeUnavailable, true
+	}
+	return out
+}
+
+func isGRPCStatus(err error) bool {
+	st, ok := status.FromError(err)
+	return ok && st.Code() != codes.OK && st.Code() != codes.Unknown
+}
+
+func codeForHTTPStatus(status int) Code {
+	switch {
+	case status == http.StatusBadRequest, status == http.StatusUnprocessableEntity:
+		return CodeInvalidArgument
+	case status == http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case status == http.StatusForbidden:
+		return CodePermissionDenied
+	case status == http.StatusNotFound:
+		return CodeNotFound
+	case status == http.StatusConflict, status == http.StatusPreconditionFailed:
+		return CodeConflict
+	case status == http.StatusTooManyRequests:
+		return CodeRateLimited
+	case status == http.StatusRequestTimeout, status == http.StatusGatewayTimeout:
+		return CodeTimeout
+	case status == http.StatusServiceUnavailable, status == http.StatusBadGateway:
+		return CodeUnavailable
+	case status >= 500:
+		return CodeInternal
+	default:
+		return CodeUnknown
+	}
+}
+
+func retryableHTTPStatus(status int) bool {
+	switch status {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func codeForGRPC(c codes.Code) Code {
+	switch c {
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return CodeInvalidArgument
+	case codes.Unauthenticated:
+		return CodeUnauthenticated
+	case codes.PermissionDenied:
+		return CodePermissionDenied
+	case codes.NotFound:
+		return CodeNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return CodeConflict
+	case codes.ResourceExhausted:
+		return CodeRateLimited
+	case codes.DeadlineExceeded:
+		return CodeTimeout
+	case codes.Unavailable:
+		return CodeUnavailable
+	case codes.Canceled:
+		return CodeCanceled
+	case codes.Internal, codes.DataLoss, codes.Unimplemented:
+		return CodeInternal
+	default:
+		return CodeUnknown
+	}
+}
+
+func retryableGRPC(c codes.Code) bool {
+	switch c {
+	case codes.DeadlineExceeded, codes.ResourceExhausted, codes.Unavailable, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+func retryable(code Code) bool {
+	switch code {
+	case CodeRateLimited, CodeTimeout, CodeUnavailable:
+		return true
+	}
+	return false
+}
+
+// RequestIDHeader is the response header carrying the ID Pinecone support
+// needs to trace a request.
+const RequestIDHeader = "X-Pinecone-Request-Id"
+
+var (
+	requestIDMu   sync.Mutex
+	lastRequestID string
+)
+
+// RecordRequestID remembers the request ID of a failed API response so it can
+// be reported with the error.
+func RecordRequestID(id string) {
+	requestIDMu.Lock()
+	defer requestIDMu.Unlock()
+	lastRequestID = id
+}
+
+// LastRequestID returns the request ID of the most recent failed API response.
+func LastRequestID() string {
+	requestIDMu.Lock()
+	defer requestIDMu.Unlock()
+	return lastRequestID
+}
+
+// RequestIDTransport records the request ID of failed responses; see
+// RecordRequestID.
+type RequestIDTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *RequestIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err == nil && resp.StatusCode >= 400 {
+		if id := resp.Header.Get(RequestIDHeader); id != "" {
+			RecordRequestID(id)
+		}
+	}
+	return resp, err
+}
@@ -0,0 +1,110 @@
+package clierr
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassify_PineconeHTTPErrors(t *testing.T) {
+	tests := []struct {
+		status    int
+		code      Code
+		exit      int
+		retryable bool
+	}{
+		{status: 400, code: CodeInvalidArgument, exit: ExitUsage},
+		{status: 401, code: CodeUnauthenticated, exit: ExitAuth},
+		{status: 403, code: CodePermissionDenied, exit: ExitAuth},
+		{status: 404, code: CodeNotFound, exit: ExitNotFound},
+		{status: 409, code: CodeConflict, exit: ExitConflict},
+		{status: 429, code: CodeRateLimited, exit: ExitRateLimited, retryable: true},
+		{status: 504, code: CodeTimeout, exit: ExitTimeout, retryable: true},
+		{status: 503, code: CodeUnavailable, exit: ExitGeneric, retryable: true},
+		{status: 500, code: CodeInternal, exit: ExitGeneric, retryable: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.status), func(t *testing.T) {
+			err := fmt.Errorf("failed to describe index: %w", &pinecone.PineconeError{Code: tt.status, Msg: errors.New("boom")})
+			c := Classify(err)
+			assert.Equal(t, tt.code, c.Code)
+			assert.Equal(t, tt.status, c.HTTPStatus)
+			assert.Equal(t, tt.retryable, c.Retryable)
+			assert.Equal(t, tt.exit, ExitCodeFor(err))
+		})
+	}
+}
+
+func TestClassify_GRPCErrors(t *testing.T) {
+	err := fmt.Errorf("query failed: %w", status.Error(codes.NotFound, "namespace not found"))
+	assert.Equal(t, CodeNotFound, Classify(err).Code)
+	assert.Equal(t, ExitNotFound, ExitCodeFor(err))
+
+	err = status.Error(codes.ResourceExhausted, "slow down")
+	assert.Equal(t, CodeRateLimited, Classify(err).Code)
+	assert.True(t, Classify(err).Retryable)
+}
+
+func TestClassify_ContextAndPlainErrors(t *testing.T) {
+	assert.Equal(t, CodeTimeout, Classify(fmt.Errorf("list: %w", context.DeadlineExceeded)).Code)
+	assert.Equal(t, ExitCanceled, ExitCodeFor(context.Canceled))
+	assert.Equal(t, CodeUnknown, Classify(errors.New("something else")).Code)
+	assert.Equal(t, ExitGeneric, ExitCodeFor(errors.New("something else")))
+	assert.Nil(t, Classify(nil))
+	assert.Equal(t, 0, ExitCodeFor(nil))
+}
+
+func TestClassify_ExplicitCodes(t *testing.T) {
+	err := fmt.Errorf("outer: %w", New(CodeUsage, "--index-name is required"))
+	assert.Equal(t, ExitUsage, ExitCodeFor(err))
+	assert.Equal(t, "outer: --index-name is required", Classify(err).Message)
+
+	partial := Partial(&pinecone.PineconeError{Code: 429, Msg: errors.New("rate limited")})
+	c := Classify(partial)
+	assert.Equal(t, CodePartialFailure, c.Code)
+	assert.Equal(t, 429, c.HTTPStatus)
+	assert.True(t, c.Retryable)
+	assert.Equal(t, ExitPartialFailure, ExitCodeFor(partial))
+}
+
+func TestEnvelope_JSON(t *testing.T) {
+	out, err := json.Marshal(Envelope{Error: &Error{Code: CodeNotFound, Message: "index missing", HTTPStatus: 404, RequestID: "req-1"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":{"code":"not_found","message":"index missing","http_status":404,"request_id":"req-1","retryable":false}}`, string(out))
+
+	out, err = json.Marshal(Envelope{Error: &Error{Code: CodeUnknown, Message: "boom"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"error":{"code":"error","message":"boom","http_status":null,"request_id":null,"retryable":false}}`, string(out))
+}
+
+func TestRequestIDTransport_RecordsFailedRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(RequestIDHeader, "req-"+r.URL.Path[1:])
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	RecordRequestID("")
+
+	client := &http.Client{Transport: &RequestIDTransport{}}
+	for _, path := range []string{"/fail", "/ok"} {
+		resp, err := client.Get(srv.URL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+	assert.Equal(t, "req-fail", LastRequestID())
+
+	c := Classify(&pinecone.PineconeError{Code: 404, Msg: errors.New("missing")})
+	assert.Equal(t, "req-fail", c.RequestID)
+}
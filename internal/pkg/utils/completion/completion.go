@@ -0,0 +1,195 @@
+// Package completion suggests live resource names, such as indexes,
+// namespaces and project IDs, when completing flag values in the shell.
+// Results are cached on disk for a minute so repeated tabs stay fast, and the
+// last known values are used when the API can't be reached.
+package completion
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	// cacheTTL is how long completions are served from disk before the API
+	// is asked again.
+	cacheTTL = time.Minute
+	// fetchTimeout bounds each API call so a slow network doesn't hang the
+	// shell; the cached values are used instead.
+	fetchTimeout = 5 * time.Second
+)
+
+var store = sync.OnceValue(func() *cache.Store {
+	return cache.Open("completion", cacheTTL)
+})
+
+// flagSources complete flags that mean the same thing on every command.
+var flagSources = map[string]*source{
+	"index-name": indexes,
+	"namespace":  namespaces,
+}
+
+// commandSources complete flags whose meaning depends on the command, keyed
+// by command path.
+var commandSources = map[string]map[string]*source{
+	"pc api":                       {"index": indexes},
+	"pc serve":                     {"index": indexes},
+	"pc index create":              {"source-collection": collections},
+	"pc index collection create":   {"source": indexes},
+	"pc index collection describe": {"name": collections},
+	"pc index collection delete":   {"name": collections},
+	"pc index namespace describe":  {"name": namespaces},
+	"pc index namespace delete":    {"name": namespaces},
+	"pc index backup describe":     {"id": backups},
+	"pc index backup delete":       {"id": backups},
+	"pc index restore":             {"id": backups},
+	"pc index restore describe":    {"id": restoreJobs},
+	"pc index import describe":     {"id": imports},
+	"pc index import cancel":       {"id": imports},
+	"pc project describe":          {"id": projects},
+	"pc project update":            {"id": projects},
+	"pc project delete":            {"id": projects},
+	"pc api-key create":            {"id": projects},
+	"pc api-key list":              {"id": projects},
+	"pc api-key describe":          {"id": apiKeys},
+	"pc api-key update":            {"id": apiKeys},
+	"pc api-key delete":            {"id": apiKeys},
+	"pc organization describe":     {"id": organizations},
+	"pc organization update":       {"id": organizations},
+	"pc organization delete":       {"id": organizations},
+	"pc target": {
+		"org":             organizationNames,
+		"organization-id": organizations,
+		"project":         projectNames,
+		"project-id":      projects,
+	},
+}
+
+// Register adds dynamic flag completion to root and its subcommands.
+func Register(root *cobra.Command) {
+	var walk func(cmd *cobra.Command)
+	walk = func(cmd *cobra.Command) {
+		sources := commandSources[cmd.CommandPath()]
+		cmd.LocalNonPersistentFlags().VisitAll(func(f *pflag.Flag) {
+			src, ok := sources[f.Name]
+			if !ok {
+				src, ok = flagSources[f.Name]
+			}
+			if !ok || f.Deprecated != "" {
+				return
+			}
+			if err := cmd.RegisterFlagCompletionFunc(f.Name, src.complete); err != nil {
+				log.Debug().Err(err).Str("command", cmd.CommandPath()).Str("flag", f.Name).Msg("Skipping flag completion")
+			}
+		})
+		for _, sub := range cmd.Commands() {
+			walk(sub)
+		}
+	}
+	walk(root)
+}
+
+// source lists the completions for one kind of resource.
+type source struct {
+	kind string
+	// admin sources use the admin API and are scoped to the target
+	// organization rather than the project.
+	admin bool
+	// perIndex sources list resources inside the index named by --index-name.
+	perIndex bool
+	list     func(ctx context.Context, c *clients, index string) ([]string, error)
+}
+
+func (s *source) complete(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	prepare(cmd)
+
+	var index string
+	if s.perIndex {
+		index, _ = cmd.Flags().GetString("index-name")
+		if index == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	c := &clients{}
+	values := lookup(store(), s.cacheKey(index), func() ([]string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+		defer cancel()
+		return s.list(ctx, c, index)
+	})
+	return filterPrefix(values, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// cacheKey scopes cached values to the environment and the credentials in
+// use, so switching projects never suggests another project's indexes.
+func (s *source) cacheKey(index string) string {
+	scope := sdk.CacheScope()
+	if s.admin && !local.Enabled() {
+		org, _ := state.ResolveTargetOrg()
+		scope = config.GetEnvironment() + "/org/" + org.Id
+	}
+	key := scope + "/" + s.kind
+	if s.perIndex {
+		key += "/" + index
+	}
+	return key
+}
+
+// lookup returns the cached values for key while they are fresh, otherwise
+// fetches and caches new ones. When fetching fails the stale values, if any,
+// are returned.
+func lookup(store *cache.Store, key string, fetch func() ([]string, error)) []string {
+	var cached []string
+	fresh, ok := store.Get(key, &cached)
+	if ok && fresh {
+		return cached
+	}
+
+	values, err := fetch()
+	if err != nil {
+		log.Debug().Err(err).Str("key", key).Msg("Completion fetch failed, using cached values")
+		return cached
+	}
+	if err := store.Put(key, values); err != nil {
+		log.Debug().Err(err).Msg("Error writing completion cache")
+	}
+	return values
+}
+
+// prepare applies the settings that the root command normally applies before
+// running a command, since completion runs without them.
+func prepare(cmd *cobra.Command) {
+	if f := cmd.Flags().Lookup("local"); f != nil && f.Value.String() == "true" {
+		local.Enable()
+	}
+	if f := cmd.Flags().Lookup("no-cache"); f != nil && f.Value.String() == "true" {
+		cache.Disable()
+	}
+	if err := projectfile.Load().ApplyFlagDefaults(cmd.Flags()); err != nil {
+		log.Debug().Err(err).Msg("Error applying project file defaults for completion")
+	}
+}
+
+// filterPrefix keeps the completions whose value, before any tab-separated
+// description, starts with prefix.
+func filterPrefix(values []string, prefix string) []cobra.Completion {
+	out := []cobra.Completion{}
+	for _, v := range values {
+		value, _, _ := strings.Cut(v, "\t")
+		if strings.HasPrefix(value, prefix) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
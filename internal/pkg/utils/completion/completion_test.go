@@ -0,0 +1,101 @@
+package completion
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStore(t *testing.T, ttl time.Duration) *cache.Store {
+	return cache.NewStore(filepath.Join(t.TempDir(), "completion.json"), ttl)
+}
+
+func Test_lookup_FetchesAndCaches(t *testing.T) {
+	s := newTestStore(t, time.Minute)
+	calls := 0
+	fetch := func() ([]string, error) {
+		calls++
+		return []string{"a", "b"}, nil
+	}
+
+	assert.Equal(t, []string{"a", "b"}, lookup(s, "k", fetch))
+	assert.Equal(t, []string{"a", "b"}, lookup(s, "k", fetch))
+	assert.Equal(t, 1, calls, "second lookup should be served from the cache")
+}
+
+func Test_lookup_StaleValuesUsedWhenFetchFails(t *testing.T) {
+	s := newTestStore(t, 0)
+	lookup(s, "k", func() ([]string, error) { return []string{"cached"}, nil })
+
+	got := lookup(s, "k", func() ([]string, error) { return nil, errors.New("offline") })
+	assert.Equal(t, []string{"cached"}, got)
+}
+
+func Test_lookup_StaleValuesRefreshed(t *testing.T) {
+	s := newTestStore(t, 0)
+	lookup(s, "k", func() ([]string, error) { return []string{"old"}, nil })
+
+	got := lookup(s, "k", func() ([]string, error) { return []string{"new"}, nil })
+	assert.Equal(t, []string{"new"}, got)
+}
+
+func Test_filterPrefix(t *testing.T) {
+	values := []string{"docs\t10 records", "dev", "prod\t3 records"}
+	assert.Equal(t, []cobra.Completion{"docs\t10 records", "dev"}, filterPrefix(values, "d"))
+	assert.Equal(t, []cobra.Completion{}, filterPrefix(values, "x"))
+	assert.Len(t, filterPrefix(values, ""), 3)
+}
+
+func Test_Register(t *testing.T) {
+	root := &cobra.Command{Use: "pc"}
+	index := &cobra.Command{Use: "index"}
+	describe := &cobra.Command{Use: "describe", Run: func(*cobra.Command, []string) {}}
+	describe.Flags().String("index-name", "", "")
+	describe.Flags().String("name", "", "")
+	_ = describe.Flags().MarkDeprecated("name", "use --index-name instead")
+	backup := &cobra.Command{Use: "backup"}
+	backupDescribe := &cobra.Command{Use: "describe", Run: func(*cobra.Command, []string) {}}
+	backupDescribe.Flags().String("id", "", "")
+	vector := &cobra.Command{Use: "vector"}
+	query := &cobra.Command{Use: "query", Run: func(*cobra.Command, []string) {}}
+	query.Flags().String("id", "", "")
+	query.Flags().String("namespace", "", "")
+	root.AddCommand(index)
+	index.AddCommand(describe, backup, vector)
+	backup.AddCommand(backupDescribe)
+	vector.AddCommand(query)
+
+	Register(root)
+
+	registered := func(cmd *cobra.Command, flag string) bool {
+		_, ok := cmd.GetFlagCompletionFunc(flag)
+		return ok
+	}
+	assert.True(t, registered(describe, "index-name"))
+	assert.False(t, registered(describe, "name"), "deprecated flags are not completed")
+	assert.True(t, registered(backupDescribe, "id"))
+	assert.True(t, registered(query, "namespace"))
+	assert.False(t, registered(query, "id"), "vector IDs are not completed")
+}
+
+func Test_source_complete_PerIndexNeedsIndexName(t *testing.T) {
+	called := false
+	src := &source{kind: "test", perIndex: true, list: func(context.Context, *clients, string) ([]string, error) {
+		called = true
+		return nil, nil
+	}}
+	cmd := &cobra.Command{Use: "query"}
+	cmd.Flags().String("index-name", "", "")
+
+	got, directive := src.complete(cmd, nil, "")
+	require.Empty(t, got)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.False(t, called)
+}
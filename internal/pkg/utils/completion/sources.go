@@ -0,0 +1,284 @@
+package completion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// listLimit caps paginated lists; completion only needs the first page.
+const listLimit = 100
+
+// clients creates the SDK clients lazily, so cache hits make no API calls.
+type clients struct {
+	pc *pinecone.Client
+	ac *pinecone.AdminClient
+}
+
+func (c *clients) client(ctx context.Context) (*pinecone.Client, error) {
+	if c.pc == nil {
+		pc, err := sdk.TryNewPineconeClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.pc = pc
+	}
+	return c.pc, nil
+}
+
+func (c *clients) admin(ctx context.Context) (*pinecone.AdminClient, error) {
+	if c.ac == nil {
+		ac, err := sdk.TryNewPineconeAdminClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.ac = ac
+	}
+	return c.ac, nil
+}
+
+func (c *clients) index(ctx context.Context, name string) (*pinecone.IndexConnection, error) {
+	pc, err := c.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewIndexConnection(ctx, pc, name, "")
+}
+
+// withDescription formats a completion with a description shown by shells
+// that support them.
+func withDescription(value, description string) string {
+	if description == "" {
+		return value
+	}
+	return value + "\t" + description
+}
+
+var indexes = &source{
+	kind: "indexes",
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		pc, err := c.client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list, err := pc.ListIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(list))
+		for _, idx := range list {
+			desc := string(idx.Metric)
+			if idx.Dimension != nil {
+				desc = fmt.Sprintf("%d dims, %s", *idx.Dimension, idx.Metric)
+			}
+			out = append(out, withDescription(idx.Name, desc))
+		}
+		return out, nil
+	},
+}
+
+var namespaces = &source{
+	kind:     "namespaces",
+	perIndex: true,
+	list: func(ctx context.Context, c *clients, index string) ([]string, error) {
+		ic, err := c.index(ctx, index)
+		if err != nil {
+			return nil, err
+		}
+		defer ic.Close()
+		limit := uint32(listLimit)
+		resp, err := ic.ListNamespaces(ctx, &pinecone.ListNamespacesParams{Limit: &limit})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(resp.Namespaces))
+		for _, ns := range resp.Namespaces {
+			out = append(out, withDescription(ns.Name, fmt.Sprintf("%d records", ns.RecordCount)))
+		}
+		return out, nil
+	},
+}
+
+var collections = &source{
+	kind: "collections",
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		pc, err := c.client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list, err := pc.ListCollections(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(list))
+		for _, col := range list {
+			out = append(out, withDescription(col.Name, string(col.Status)))
+		}
+		return out, nil
+	},
+}
+
+var backups = &source{
+	kind: "backups",
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		pc, err := c.client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		limit := listLimit
+		resp, err := pc.ListBackups(ctx, &pinecone.ListBackupsParams{Limit: &limit})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(resp.Data))
+		for _, b := range resp.Data {
+			desc := b.SourceIndexName
+			if b.Name != nil && *b.Name != "" {
+				desc = *b.Name + " (" + b.SourceIndexName + ")"
+			}
+			out = append(out, withDescription(b.BackupId, desc))
+		}
+		return out, nil
+	},
+}
+
+var restoreJobs = &source{
+	kind: "restore-jobs",
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		pc, err := c.client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		limit := listLimit
+		resp, err := pc.ListRestoreJobs(ctx, &pinecone.ListRestoreJobsParams{Limit: &limit})
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(resp.Data))
+		for _, job := range resp.Data {
+			out = append(out, withDescription(job.RestoreJobId, job.TargetIndexName+", "+job.Status))
+		}
+		return out, nil
+	},
+}
+
+var imports = &source{
+	kind:     "imports",
+	perIndex: true,
+	list: func(ctx context.Context, c *clients, index string) ([]string, error) {
+		ic, err := c.index(ctx, index)
+		if err != nil {
+			return nil, err
+		}
+		defer ic.Close()
+		limit := int32(listLimit)
+		resp, err := ic.ListImports(ctx, &limit, nil)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(resp.Imports))
+		for _, imp := range resp.Imports {
+			out = append(out, withDescription(imp.Id, string(imp.Status)))
+		}
+		return out, nil
+	},
+}
+
+var projects = &source{
+	kind:  "projects",
+	admin: true,
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		return listProjects(ctx, c, func(p *pinecone.Project) string {
+			return withDescription(p.Id, p.Name)
+		})
+	},
+}
+
+var projectNames = &source{
+	kind:  "project-names",
+	admin: true,
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		return listProjects(ctx, c, func(p *pinecone.Project) string {
+			return p.Name
+		})
+	},
+}
+
+func listProjects(ctx context.Context, c *clients, format func(*pinecone.Project) string) ([]string, error) {
+	ac, err := c.admin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := ac.Project.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(list))
+	for _, p := range list {
+		out = append(out, format(p))
+	}
+	return out, nil
+}
+
+var organizations = &source{
+	kind:  "organizations",
+	admin: true,
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		return listOrganizations(ctx, c, func(o *pinecone.Organization) string {
+			return withDescription(o.Id, o.Name)
+		})
+	},
+}
+
+var organizationNames = &source{
+	kind:  "organization-names",
+	admin: true,
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		return listOrganizations(ctx, c, func(o *pinecone.Organization) string {
+			return o.Name
+		})
+	},
+}
+
+func listOrganizations(ctx context.Context, c *clients, format func(*pinecone.Organization) string) ([]string, error) {
+	ac, err := c.admin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := ac.Organization.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(list))
+	for _, o := range list {
+		out = append(out, format(o))
+	}
+	return out, nil
+}
+
+// apiKeys lists the keys of the target project.
+var apiKeys = &source{
+	kind: "api-keys",
+	list: func(ctx context.Context, c *clients, _ string) ([]string, error) {
+		project, _ := state.ResolveTargetProject()
+		if project.Id == "" {
+			return nil, fmt.Errorf("no target project set")
+		}
+		ac, err := c.admin(ctx)
+		if err != nil {
+			return nil, err
+		}
+		list, err := ac.APIKey.List(ctx, project.Id)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]string, 0, len(list))
+		for _, k := range list {
+			out = append(out, withDescription(k.Id, k.Name))
+		}
+		return out, nil
+	},
+}
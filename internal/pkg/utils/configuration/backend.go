@@ -0,0 +1,177 @@
+package configuration
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/safefile"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/spf13/viper"
+)
+
+// Backend persists the settings of a ConfigFile somewhere other than the
+// plaintext file viper manages in the config directory, e.g. the OS keyring or
+// an encrypted file. Settings are the flat key/value map viper holds in memory.
+type Backend interface {
+	// Name identifies the backend in output and configuration (e.g. "keyring").
+	Name() string
+	// Load returns the persisted settings, or an empty map if nothing has been stored.
+	Load() (map[string]any, error)
+	// Save replaces the persisted settings.
+	Save(settings map[string]any) error
+	// Clear removes everything the backend has persisted.
+	Clear() error
+}
+
+type backendEntry struct {
+	backend Backend
+	// lockPath is the file whose lock serializes writers across processes.
+	lockPath string
+	// lazy stores are read from the backend on first use rather than by viper in Init.
+	lazy bool
+	once sync.Once
+	err  error
+}
+
+// backends maps a viper store to the Backend persisting it. Properties only
+// hold a reference to their viper store, so the registry is how they find the
+// backend on read and write.
+var backends sync.Map
+
+func registerBackend(v *viper.Viper, b Backend, lockPath string, lazy bool) {
+	backends.Store(v, &backendEntry{backend: b, lockPath: lockPath, lazy: lazy})
+}
+
+func lookupBackend(v *viper.Viper) *backendEntry {
+	entry, ok := backends.Load(v)
+	if !ok {
+		return nil
+	}
+	return entry.(*backendEntry)
+}
+
+// ensureLoaded reads a backend-persisted store on first use. Loading lazily
+// means commands that never touch the store never unlock the keyring or
+// prompt for a passphrase.
+func ensureLoaded(v *viper.Viper) {
+	entry := lookupBackend(v)
+	if entry == nil || !entry.lazy {
+		return
+	}
+	entry.once.Do(func() {
+		settings, err := entry.backend.Load()
+		if err != nil {
+			entry.err = err
+			return
+		}
+		entry.err = v.MergeConfigMap(settings)
+	})
+	if entry.err != nil {
+		exit.Errorf(entry.err, "Error loading settings from %s", entry.backend.Name())
+	}
+}
+
+// updateStored applies mut to the persisted settings of the store while
+// holding its file lock. Re-reading the persisted settings under the lock
+// merges this process's change with any made concurrently by other pc
+// processes instead of overwriting them with a stale in-memory copy. If mut
+// returns an error nothing is written.
+func updateStored(v *viper.Viper, mut func(settings map[string]any) error) error {
+	entry := lookupBackend(v)
+	if entry == nil {
+		settings := map[string]any{}
+		if err := mut(settings); err != nil {
+			return err
+		}
+		for key, value := range settings {
+			v.Set(key, value)
+		}
+		return v.WriteConfig()
+	}
+
+	unlock, err := safefile.Lock(entry.lockPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	settings, err := entry.backend.Load()
+	if err != nil {
+		return err
+	}
+	if err := mut(settings); err != nil {
+		return err
+	}
+	return entry.backend.Save(settings)
+}
+
+// readStored returns the value persisted for key, bypassing env var overrides.
+// ok is false when nothing is persisted for the key.
+func readStored(v *viper.Viper, key string) (value any, ok bool) {
+	if entry := lookupBackend(v); entry != nil {
+		settings, err := entry.backend.Load()
+		if err != nil {
+			return nil, false
+		}
+		value, ok = settings[key]
+		return value, ok && value != nil
+	}
+
+	stored := viper.New()
+	stored.SetConfigFile(v.ConfigFileUsed())
+	if err := stored.ReadInConfig(); err != nil {
+		return nil, false
+	}
+	value = stored.Get(key)
+	return value, value != nil
+}
+
+// FileBackend persists settings as a plaintext YAML file. It is the format
+// viper writes natively and is used to read and clear that file when moving
+// settings to another backend.
+type FileBackend struct {
+	Path string
+}
+
+func (f FileBackend) Name() string {
+	return "file"
+}
+
+func (f FileBackend) Load() (map[string]any, error) {
+	v := viper.New()
+	v.SetConfigFile(f.Path)
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return map[string]any{}, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", f.Path, err)
+	}
+	return v.AllSettings(), nil
+}
+
+func (f FileBackend) Save(settings map[string]any) error {
+	v := viper.New()
+	v.SetConfigType("yaml")
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	var buf bytes.Buffer
+	if err := v.WriteConfigTo(&buf); err != nil {
+		return err
+	}
+	return safefile.WriteFile(f.Path, buf.Bytes(), 0o600)
+}
+
+func (f FileBackend) Clear() error {
+	log.Debug().Str("path", f.Path).Msg("Removing plaintext settings file")
+	err := os.Remove(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
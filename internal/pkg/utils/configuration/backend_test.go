@@ -0,0 +1,150 @@
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type memoryBackend struct {
+	settings map[string]any
+	loads    int
+}
+
+func (m *memoryBackend) Name() string { return "memory" }
+
+func (m *memoryBackend) Load() (map[string]any, error) {
+	m.loads++
+	out := map[string]any{}
+	for k, v := range m.settings {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (m *memoryBackend) Save(settings map[string]any) error {
+	m.settings = settings
+	return nil
+}
+
+func (m *memoryBackend) Clear() error {
+	m.settings = map[string]any{}
+	return nil
+}
+
+func TestBackend_PropertiesReadAndWriteThroughBackend(t *testing.T) {
+	v := viper.New()
+	backend := &memoryBackend{settings: map[string]any{"api_key": "stored"}}
+	apiKey := ConfigProperty[string]{KeyName: "api_key", ViperStore: v, DefaultValue: ""}
+	apiKey.Init()
+	registerBackend(v, backend, filepath.Join(t.TempDir(), "secrets.yaml"), true)
+
+	assert.Equal(t, 0, backend.loads, "backend should load lazily")
+	assert.Equal(t, "stored", apiKey.Get())
+
+	apiKey.Set("updated")
+	assert.Equal(t, "updated", backend.settings["api_key"])
+	assert.Equal(t, "updated", apiKey.GetStored())
+}
+
+func TestBackend_GetStoredReturnsDefaultWhenAbsent(t *testing.T) {
+	v := viper.New()
+	registerBackend(v, &memoryBackend{settings: map[string]any{}}, filepath.Join(t.TempDir(), "secrets.yaml"), true)
+	clientId := ConfigProperty[string]{KeyName: "client_id", ViperStore: v, DefaultValue: "none"}
+
+	assert.Equal(t, "none", clientId.GetStored())
+}
+
+func TestFileBackend_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	f := FileBackend{Path: path}
+
+	loaded, err := f.Load()
+	require.NoError(t, err)
+	assert.Empty(t, loaded, "missing file should load as empty")
+
+	require.NoError(t, f.Save(map[string]any{"api_key": "pcsk_secret"}))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err = f.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "pcsk_secret", loaded["api_key"])
+
+	require.NoError(t, f.Clear())
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+// newFileStore simulates another pc process: a separate viper store with its
+// own in-memory copy of the same file.
+func newFileStore(t *testing.T, path string) *viper.Viper {
+	t.Helper()
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	if _, err := os.Stat(path); err == nil {
+		require.NoError(t, v.ReadInConfig())
+	}
+	registerBackend(v, FileBackend{Path: path}, path, false)
+	return v
+}
+
+func TestUpdateStored_MergesWithConcurrentWriters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.yaml")
+	first := newFileStore(t, path)
+	second := newFileStore(t, path)
+
+	ConfigProperty[string]{KeyName: "org", ViperStore: first}.Set("org-1")
+	ConfigProperty[string]{KeyName: "project", ViperStore: second}.Set("proj-1")
+
+	stored, err := FileBackend{Path: path}.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "org-1", stored["org"], "second writer must not drop the first writer's key")
+	assert.Equal(t, "proj-1", stored["project"])
+}
+
+func TestMarshaledPropertyTryUpdate_DoesNotLoseConcurrentUpdates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	const writers = 8
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		prop := MarshaledProperty[map[string]int]{KeyName: "keys", ViperStore: newFileStore(t, path), DefaultValue: map[string]int{}}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, prop.TryUpdate(func(keys *map[string]int) error {
+				(*keys)[fmt.Sprintf("project-%d", i)] = i
+				return nil
+			}))
+		}(i)
+	}
+	wg.Wait()
+
+	final := MarshaledProperty[map[string]int]{KeyName: "keys", ViperStore: newFileStore(t, path), DefaultValue: map[string]int{}}
+	assert.Len(t, final.Get(), writers)
+}
+
+func TestMarshaledPropertyTryUpdate_AbortsOnError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	prop := MarshaledProperty[map[string]int]{KeyName: "keys", ViperStore: newFileStore(t, path), DefaultValue: map[string]int{}}
+	prop.Set(map[string]int{"a": 1})
+
+	err := prop.TryUpdate(func(keys *map[string]int) error {
+		(*keys)["b"] = 2
+		return errors.New("boom")
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, map[string]int{"a": 1}, prop.Get())
+	assert.Empty(t, prop.DefaultValue, "default value must not be mutated")
+}
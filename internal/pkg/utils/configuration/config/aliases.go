@@ -0,0 +1,11 @@
+package config
+
+import "github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+
+// Aliases maps alias names to the pc arguments they expand to, as set with
+// pc alias set.
+var Aliases = configuration.MarshaledProperty[map[string]string]{
+	KeyName:      "aliases",
+	ViperStore:   ConfigViper,
+	DefaultValue: map[string]string{},
+}
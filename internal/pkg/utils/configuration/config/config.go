@@ -1,10 +1,11 @@
 package config
 
 import (
-	"fmt"
+	"os"
 	"strings"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/spf13/viper"
 )
@@ -22,10 +23,72 @@ var (
 		ViperStore:   ConfigViper,
 		DefaultValue: "production",
 	}
+	SecretsBackend = configuration.ConfigProperty[string]{
+		KeyName:      "secrets_backend",
+		ViperStore:   ConfigViper,
+		DefaultValue: "file",
+	}
+	SecretsAgeIdentity = configuration.ConfigProperty[string]{
+		KeyName:      "secrets_age_identity",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	CredentialHelper = configuration.ConfigProperty[string]{
+		KeyName:      "credential_helper",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	LogLevel = configuration.ConfigProperty[string]{
+		KeyName:      "log_level",
+		ViperStore:   ConfigViper,
+		DefaultValue: "off",
+	}
+	CABundle = configuration.ConfigProperty[string]{
+		KeyName:      "ca_bundle",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	ClientCert = configuration.ConfigProperty[string]{
+		KeyName:      "client_cert",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	ClientKey = configuration.ConfigProperty[string]{
+		KeyName:      "client_key",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	Proxy = configuration.ConfigProperty[string]{
+		KeyName:      "proxy",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	NoProxy = configuration.ConfigProperty[string]{
+		KeyName:      "no_proxy",
+		ViperStore:   ConfigViper,
+		DefaultValue: "",
+	}
+	PluginAPIKey = configuration.ConfigProperty[bool]{
+		KeyName:      "plugin_api_key",
+		ViperStore:   ConfigViper,
+		DefaultValue: false,
+	}
 )
 var properties = []configuration.Property{
 	Color,
 	Environment,
+	SecretsBackend,
+	SecretsAgeIdentity,
+	CredentialHelper,
+	LogLevel,
+	CABundle,
+	ClientCert,
+	ClientKey,
+	Proxy,
+	NoProxy,
+	PluginAPIKey,
+	Environments,
+	Aliases,
 }
 
 var configFile = configuration.ConfigFile{
@@ -43,23 +106,51 @@ func init() {
 	if err != nil {
 		exit.Error(err, "Error binding environment to environment variable in config file")
 	}
+	_ = ConfigViper.BindEnv(SecretsBackend.KeyName)
+	_ = ConfigViper.BindEnv(SecretsAgeIdentity.KeyName)
+	_ = ConfigViper.BindEnv(CredentialHelper.KeyName)
+	_ = ConfigViper.BindEnv(LogLevel.KeyName, "PINECONE_LOG_FILE_LEVEL")
+	_ = ConfigViper.BindEnv(CABundle.KeyName)
+	_ = ConfigViper.BindEnv(ClientCert.KeyName)
+	_ = ConfigViper.BindEnv(ClientKey.KeyName)
+	_ = ConfigViper.BindEnv(Proxy.KeyName)
+	_ = ConfigViper.BindEnv(NoProxy.KeyName)
 
-	err = validateEnvironment(Environment.Get())
+	err = ValidateEnvironment(GetEnvironment())
 	if err != nil {
 		exit.Error(err, "Error validating environment")
 	}
 }
 
-func validateEnvironment(env string) error {
-	validEnvs := []string{"production", "staging"}
-	for _, validEnv := range validEnvs {
-		if env == validEnv {
-			return nil
+// PluginAPIKeyEnabled reports whether plugins get the default API key even
+// when they have an access token: the PINECONE_PLUGIN_API_KEY variable, then
+// the global config.
+func PluginAPIKeyEnabled() bool {
+	if v := os.Getenv("PINECONE_PLUGIN_API_KEY"); v != "" {
+		switch strings.ToLower(v) {
+		case "true", "on", "1":
+			return true
+		default:
+			return false
 		}
 	}
-	quotedEnvs := make([]string, len(validEnvs))
-	for i, validEnv := range validEnvs {
-		quotedEnvs[i] = fmt.Sprintf("%q", validEnv)
+	return PluginAPIKey.GetStored()
+}
+
+// GetEnvironment returns the effective environment. See ResolveEnvironment.
+func GetEnvironment() string {
+	env, _ := ResolveEnvironment()
+	return env
+}
+
+// ResolveEnvironment returns the effective environment and where it came from:
+// the PINECONE_ENVIRONMENT variable, then the project file, then the global config.
+func ResolveEnvironment() (string, configuration.Source) {
+	if os.Getenv("PINECONE_ENVIRONMENT") != "" {
+		return Environment.Get(), configuration.SourceEnv
+	}
+	if pf := projectfile.Load(); pf != nil && pf.Environment != "" {
+		return pf.Environment, configuration.SourceProjectFile
 	}
-	return fmt.Errorf("invalid environment: \"%s\", must be one of %s", env, strings.Join(quotedEnvs, ", "))
+	return Environment.Get(), configuration.SourceGlobal
 }
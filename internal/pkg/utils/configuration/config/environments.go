@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+)
+
+// BuiltinEnvironments are the environments the CLI ships with. They can't be
+// redefined or removed.
+var BuiltinEnvironments = []string{"production", "staging"}
+
+// CustomEnvironment is a user-defined environment, such as a local emulator or
+// a private endpoint, added with pc config environment add.
+type CustomEnvironment struct {
+	APIURL        string `json:"api_url"`
+	DashboardURL  string `json:"dashboard_url,omitempty"`
+	Auth0URL      string `json:"auth0_url,omitempty"`
+	Auth0ClientId string `json:"auth0_client_id,omitempty"`
+	Auth0Audience string `json:"auth0_audience,omitempty"`
+	// IndexHost replaces the host of every index; "{index}" is replaced with
+	// the index name.
+	IndexHost string `json:"index_host,omitempty"`
+}
+
+var Environments = configuration.MarshaledProperty[map[string]CustomEnvironment]{
+	KeyName:      "environments",
+	ViperStore:   ConfigViper,
+	DefaultValue: map[string]CustomEnvironment{},
+}
+
+// CustomEnvironmentByName returns the user-defined environment called name.
+func CustomEnvironmentByName(name string) (CustomEnvironment, bool) {
+	env, ok := Environments.Get()[name]
+	return env, ok
+}
+
+// ValidateEnvironment checks that env is a built-in or user-defined environment.
+func ValidateEnvironment(env string) error {
+	if slices.Contains(BuiltinEnvironments, env) {
+		return nil
+	}
+	if _, ok := CustomEnvironmentByName(env); ok {
+		return nil
+	}
+	valid := append([]string{}, BuiltinEnvironments...)
+	for name := range Environments.Get() {
+		valid = append(valid, name)
+	}
+	slices.Sort(valid[len(BuiltinEnvironments):])
+	quoted := make([]string, len(valid))
+	for i, name := range valid {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+	return fmt.Errorf("invalid environment: \"%s\", must be one of %s", env, strings.Join(quoted, ", "))
+}
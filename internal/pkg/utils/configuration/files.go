@@ -17,6 +17,14 @@ type ConfigFile struct {
 	FileFormat string
 	Properties []Property
 	ViperStore *viper.Viper
+	// Backend optionally persists the settings somewhere other than a plaintext
+	// file in the config directory. It must be set before Init.
+	Backend Backend
+}
+
+// Path returns the location of the plaintext file in the config directory.
+func (c ConfigFile) Path() string {
+	return filepath.Join(NewConfigLocations().ConfigPath, fmt.Sprintf("%s.%s", c.FileName, c.FileFormat))
 }
 
 func (c ConfigFile) Init() {
@@ -30,12 +38,20 @@ func (c ConfigFile) Init() {
 	for _, property := range c.Properties {
 		property.Init()
 	}
+
+	// Backend-persisted settings are loaded lazily on first access
+	if c.Backend != nil {
+		log.Trace().Str("file_name", c.FileName).Str("backend", c.Backend.Name()).Msg("Using settings backend")
+		registerBackend(c.ViperStore, c.Backend, c.Path(), true)
+		return
+	}
+	registerBackend(c.ViperStore, FileBackend{Path: c.Path()}, c.Path(), false)
+
 	c.ViperStore.SafeWriteConfig()
 
 	// Set permissions on config file
 	if runtime.GOOS == "darwin" || runtime.GOOS == "linux" {
-		path := filepath.Join(locations.ConfigPath, fmt.Sprintf("%s.%s", c.FileName, c.FileFormat))
-		os.Chmod(path, 0o600)
+		os.Chmod(c.Path(), 0o600)
 	}
 
 	c.LoadConfig()
@@ -49,7 +65,6 @@ func (c ConfigFile) Clear() {
 			Msg("Clearing property")
 		property.Clear()
 	}
-	c.Save()
 }
 
 func (c ConfigFile) LoadConfig() {
@@ -59,10 +74,3 @@ func (c ConfigFile) LoadConfig() {
 		exit.Error(err, "Error loading config file")
 	}
 }
-
-func (c ConfigFile) Save() {
-	err := c.ViperStore.WriteConfig()
-	if err != nil {
-		exit.Error(err, "Error saving config file")
-	}
-}
@@ -52,6 +52,11 @@ func ConfigDirPath() string {
 	return configPath
 }
 
+// LogsDirPath returns the directory holding the CLI's log files.
+func LogsDirPath() string {
+	return filepath.Join(ConfigDirPath(), "logs")
+}
+
 type ConfigLocations struct {
 	ConfigPath string
 }
@@ -0,0 +1,143 @@
+// Package projectfile discovers and loads the per-directory project config file
+// (.pinecone.yaml). The file is looked up in the current working directory and
+// each of its parents, so every repository can be bound to its own Pinecone
+// organization, project, default index, and namespace without changing the
+// global target context.
+package projectfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const FileName = ".pinecone.yaml"
+
+// ProjectFile holds the settings pinned by a .pinecone.yaml file. Empty fields
+// are not pinned and fall through to the global config and state.
+type ProjectFile struct {
+	Path           string `json:"path"`
+	OrganizationId string `json:"organization_id,omitempty"`
+	ProjectId      string `json:"project_id,omitempty"`
+	IndexName      string `json:"index_name,omitempty"`
+	Namespace      string `json:"namespace,omitempty"`
+	Environment    string `json:"environment,omitempty"`
+}
+
+var (
+	loadOnce sync.Once
+	loaded   *ProjectFile
+)
+
+// Load returns the project file for the current working directory, or nil if
+// none is found. The result is cached for the lifetime of the process. A file
+// that exists but cannot be parsed is logged and treated as absent.
+func Load() *ProjectFile {
+	loadOnce.Do(func() {
+		cwd, err := os.Getwd()
+		if err != nil {
+			log.Debug().Err(err).Msg("Error getting working directory for project file lookup")
+			return
+		}
+		pf, err := Find(cwd)
+		if err != nil {
+			log.Error().Err(err).Msg("Error loading project file")
+			return
+		}
+		loaded = pf
+	})
+	return loaded
+}
+
+// Find walks up from dir looking for a .pinecone.yaml file and returns the
+// first one found. It returns nil with no error if no file exists in dir or
+// any of its parents.
+func Find(dir string) (*ProjectFile, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		path := filepath.Join(dir, FileName)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return Read(path)
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// Read parses the project file at path.
+func Read(path string) (*ProjectFile, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	log.Debug().Str("path", path).Msg("Loaded project file")
+	return &ProjectFile{
+		Path:           path,
+		OrganizationId: v.GetString("organization_id"),
+		ProjectId:      v.GetString("project_id"),
+		IndexName:      v.GetString("index_name"),
+		Namespace:      v.GetString("namespace"),
+		Environment:    v.GetString("environment"),
+	}, nil
+}
+
+// flagDefaultAnnotation marks the flags that ApplyFlagDefaults may fill in.
+const flagDefaultAnnotation = "pinecone_project_file_default"
+
+// AllowFlagDefaults lets ApplyFlagDefaults fill the named flags from the
+// project file. Only read and data-plane commands opt in: the target of a
+// delete or configure command must always be passed explicitly.
+func AllowFlagDefaults(flags *pflag.FlagSet, names ...string) {
+	for _, name := range names {
+		_ = flags.SetAnnotation(name, flagDefaultAnnotation, []string{"true"})
+	}
+}
+
+// ApplyFlagDefaults fills --index-name and --namespace from the project file
+// when the command allows it with AllowFlagDefaults and the user did not pass
+// them. It must run before cobra validates required flags (e.g. from
+// PersistentPreRun).
+func (p *ProjectFile) ApplyFlagDefaults(flags *pflag.FlagSet) error {
+	if p == nil {
+		return nil
+	}
+
+	// --name is the deprecated alias of --index-name on some index commands and
+	// shares its destination, so a default must not overwrite it.
+	if p.IndexName != "" && !flags.Changed("name") {
+		if err := setFlagDefault(flags, "index-name", p.IndexName); err != nil {
+			return err
+		}
+	}
+	if p.Namespace != "" {
+		if err := setFlagDefault(flags, "namespace", p.Namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setFlagDefault(flags *pflag.FlagSet, name, value string) error {
+	flag := flags.Lookup(name)
+	if flag == nil || flag.Changed || flag.Annotations[flagDefaultAnnotation] == nil {
+		return nil
+	}
+	log.Debug().Str("flag", name).Str("value", value).Msg("Applying flag default from project file")
+	return flags.Set(name, value)
+}
@@ -0,0 +1,150 @@
+package projectfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProjectFile(t *testing.T, dir, contents string) string {
+	t.Helper()
+	path := filepath.Join(dir, FileName)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o644))
+	return path
+}
+
+func TestFind_WalksUpToParentDirectory(t *testing.T) {
+	root := t.TempDir()
+	path := writeProjectFile(t, root, "project_id: proj-123\nindex_name: docs\n")
+	nested := filepath.Join(root, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+
+	pf, err := Find(nested)
+
+	require.NoError(t, err)
+	require.NotNil(t, pf)
+	assert.Equal(t, path, pf.Path)
+	assert.Equal(t, "proj-123", pf.ProjectId)
+	assert.Equal(t, "docs", pf.IndexName)
+}
+
+func TestFind_PrefersNearestFile(t *testing.T) {
+	root := t.TempDir()
+	writeProjectFile(t, root, "project_id: outer\n")
+	nested := filepath.Join(root, "inner")
+	require.NoError(t, os.MkdirAll(nested, 0o755))
+	writeProjectFile(t, nested, "project_id: inner\n")
+
+	pf, err := Find(nested)
+
+	require.NoError(t, err)
+	require.NotNil(t, pf)
+	assert.Equal(t, "inner", pf.ProjectId)
+}
+
+func TestFind_ReturnsNilWhenAbsent(t *testing.T) {
+	pf, err := Find(t.TempDir())
+
+	assert.NoError(t, err)
+	assert.Nil(t, pf)
+}
+
+func TestRead_ParsesAllFields(t *testing.T) {
+	path := writeProjectFile(t, t.TempDir(), `
+organization_id: org-1
+project_id: proj-1
+index_name: my-index
+namespace: tenant-a
+environment: staging
+`)
+
+	pf, err := Read(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectFile{
+		Path:           path,
+		OrganizationId: "org-1",
+		ProjectId:      "proj-1",
+		IndexName:      "my-index",
+		Namespace:      "tenant-a",
+		Environment:    "staging",
+	}, pf)
+}
+
+func TestRead_InvalidYAML(t *testing.T) {
+	path := writeProjectFile(t, t.TempDir(), "project_id: [unterminated\n")
+
+	_, err := Read(path)
+
+	assert.Error(t, err)
+}
+
+func newFlagSet() (*pflag.FlagSet, *string, *string) {
+	var indexName, namespace string
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.StringVar(&indexName, "index-name", "", "")
+	flags.StringVar(&indexName, "name", "", "")
+	flags.StringVar(&namespace, "namespace", "", "")
+	AllowFlagDefaults(flags, "index-name", "namespace")
+	return flags, &indexName, &namespace
+}
+
+func TestApplyFlagDefaults_FillsUnsetFlags(t *testing.T) {
+	flags, indexName, namespace := newFlagSet()
+	pf := &ProjectFile{IndexName: "docs", Namespace: "en"}
+
+	require.NoError(t, pf.ApplyFlagDefaults(flags))
+
+	assert.Equal(t, "docs", *indexName)
+	assert.Equal(t, "en", *namespace)
+	assert.True(t, flags.Changed("index-name"), "defaulted flag should satisfy required-flag checks")
+}
+
+func TestApplyFlagDefaults_ExplicitFlagsWin(t *testing.T) {
+	flags, indexName, namespace := newFlagSet()
+	require.NoError(t, flags.Parse([]string{"--index-name", "other", "--namespace", "fr"}))
+	pf := &ProjectFile{IndexName: "docs", Namespace: "en"}
+
+	require.NoError(t, pf.ApplyFlagDefaults(flags))
+
+	assert.Equal(t, "other", *indexName)
+	assert.Equal(t, "fr", *namespace)
+}
+
+func TestApplyFlagDefaults_DeprecatedNameFlagWins(t *testing.T) {
+	flags, indexName, _ := newFlagSet()
+	require.NoError(t, flags.Parse([]string{"--name", "legacy"}))
+	pf := &ProjectFile{IndexName: "docs"}
+
+	require.NoError(t, pf.ApplyFlagDefaults(flags))
+
+	assert.Equal(t, "legacy", *indexName)
+}
+
+func TestApplyFlagDefaults_OnlyFillsAllowedFlags(t *testing.T) {
+	var indexName, namespace string
+	flags := pflag.NewFlagSet("delete", pflag.ContinueOnError)
+	flags.StringVar(&indexName, "index-name", "", "")
+	flags.StringVar(&namespace, "namespace", "", "")
+	AllowFlagDefaults(flags, "namespace")
+	pf := &ProjectFile{IndexName: "prod-docs", Namespace: "en"}
+
+	require.NoError(t, pf.ApplyFlagDefaults(flags))
+
+	assert.Empty(t, indexName)
+	assert.False(t, flags.Changed("index-name"), "a flag that isn't allowed must still fail required-flag checks")
+	assert.Equal(t, "en", namespace)
+}
+
+func TestApplyFlagDefaults_SkipsMissingFlagsAndNilFile(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+
+	assert.NoError(t, (&ProjectFile{IndexName: "docs", Namespace: "en"}).ApplyFlagDefaults(flags))
+
+	var pf *ProjectFile
+	assert.NoError(t, pf.ApplyFlagDefaults(flags))
+}
@@ -2,6 +2,7 @@ package configuration
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/pinecone-io/cli/internal/pkg/utils/log"
@@ -26,15 +27,20 @@ func (c ConfigProperty[T]) Init() {
 
 func (c ConfigProperty[T]) Set(value T) {
 	log.Trace().Str("key", c.KeyName).Msg("Setting value for property")
-	c.ViperStore.Set(c.KeyName, value)
-	err := c.ViperStore.WriteConfig()
+	ensureLoaded(c.ViperStore)
+	err := updateStored(c.ViperStore, func(settings map[string]any) error {
+		settings[c.KeyName] = value
+		return nil
+	})
 	if err != nil {
 		exit.Error(err, "Error writing config file")
 	}
+	c.ViperStore.Set(c.KeyName, value)
 }
 
 func (c ConfigProperty[T]) Get() T {
 	log.Trace().Str("key", c.KeyName).Msg("Reading value for property")
+	ensureLoaded(c.ViperStore)
 	return c.ViperStore.Get(c.KeyName).(T)
 }
 
@@ -42,13 +48,8 @@ func (c ConfigProperty[T]) Get() T {
 // environment variable overrides. Use this for change-detection comparisons
 // where the persisted value matters rather than the effective runtime value.
 func (c ConfigProperty[T]) GetStored() T {
-	v := viper.New()
-	v.SetConfigFile(c.ViperStore.ConfigFileUsed())
-	if err := v.ReadInConfig(); err != nil {
-		return c.DefaultValue
-	}
-	result := v.Get(c.KeyName)
-	if result == nil {
+	result, ok := readStored(c.ViperStore, c.KeyName)
+	if !ok {
 		return c.DefaultValue
 	}
 	return result.(T)
@@ -79,22 +80,80 @@ func (c MarshaledProperty[T]) Set(value T) {
 	if err != nil {
 		exit.Errorf(err, "Error marshalling value for property %s", c.KeyName)
 	}
-	c.ViperStore.Set(c.KeyName, string(bytes))
-	err = c.ViperStore.WriteConfig()
+	ensureLoaded(c.ViperStore)
+	err = updateStored(c.ViperStore, func(settings map[string]any) error {
+		settings[c.KeyName] = string(bytes)
+		return nil
+	})
 	if err != nil {
 		exit.Errorf(err, "Error writing config file")
 	}
+	c.ViperStore.Set(c.KeyName, string(bytes))
 }
 
 // Update loads the current or default value, lets the caller mutate it, then writes it back
 func (c MarshaledProperty[T]) Update(mut func(*T)) {
-	curr := c.Get()
-	mut(&curr)
-	c.Set(curr)
+	err := c.TryUpdate(func(curr *T) error {
+		mut(curr)
+		return nil
+	})
+	if err != nil {
+		exit.Errorf(err, "Error writing config file")
+	}
+}
+
+// TryUpdate is like Update, but the value is read from the file rather than
+// memory and the file stays locked until it is written back, so concurrent pc
+// processes cannot lose each other's changes. If mut returns an error nothing
+// is written and the error is returned.
+func (c MarshaledProperty[T]) TryUpdate(mut func(*T) error) error {
+	log.Trace().Str("key", c.KeyName).Msg("Updating value for property")
+	ensureLoaded(c.ViperStore)
+	var updated string
+	err := updateStored(c.ViperStore, func(settings map[string]any) error {
+		stored, _ := settings[c.KeyName].(string)
+		curr, err := c.decode(stored)
+		if err != nil {
+			return err
+		}
+		if err := mut(&curr); err != nil {
+			return err
+		}
+		bytes, err := json.Marshal(curr)
+		if err != nil {
+			return fmt.Errorf("error marshalling value for property %s: %w", c.KeyName, err)
+		}
+		updated = string(bytes)
+		settings[c.KeyName] = updated
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	c.ViperStore.Set(c.KeyName, updated)
+	return nil
+}
+
+// decode unmarshals a stored value, falling back to a copy of the default so
+// callers can mutate the result without aliasing DefaultValue.
+func (c MarshaledProperty[T]) decode(str string) (T, error) {
+	var value T
+	if str == "" {
+		bytes, err := json.Marshal(c.DefaultValue)
+		if err != nil {
+			return value, err
+		}
+		str = string(bytes)
+	}
+	if err := json.Unmarshal([]byte(str), &value); err != nil {
+		return value, fmt.Errorf("error unmarshalling value for property %s: %w", c.KeyName, err)
+	}
+	return value, nil
 }
 
 func (c MarshaledProperty[T]) Get() T {
 	log.Trace().Str("key", c.KeyName).Msg("Reading value for property")
+	ensureLoaded(c.ViperStore)
 	str := c.ViperStore.GetString(c.KeyName)
 	if str == "" {
 		return c.DefaultValue
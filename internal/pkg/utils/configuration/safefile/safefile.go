@@ -0,0 +1,113 @@
+// Package safefile coordinates access to the CLI's config, secrets and state
+// files between concurrent pc processes, such as parallel CI jobs or
+// `xargs -P`. Writers hold an advisory lock on a sibling ".lock" file while
+// they read, merge and rewrite a file, and every write replaces the file
+// atomically so readers never observe a partially written file.
+package safefile
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+)
+
+// LockTimeout bounds how long Lock waits for another process to release a file.
+var LockTimeout = 10 * time.Second
+
+const lockRetryDelay = 25 * time.Millisecond
+
+// inProcess serializes goroutines of this process, which flock alone does
+// not reliably do. Each path maps to a semaphore with capacity one.
+var (
+	inProcessMu sync.Mutex
+	inProcess   = map[string]chan struct{}{}
+)
+
+func semaphore(lockPath string) chan struct{} {
+	inProcessMu.Lock()
+	defer inProcessMu.Unlock()
+	sem, ok := inProcess[lockPath]
+	if !ok {
+		sem = make(chan struct{}, 1)
+		inProcess[lockPath] = sem
+	}
+	return sem
+}
+
+// Lock takes an exclusive advisory lock for path, waiting up to LockTimeout
+// for other goroutines and processes to release it. The returned function
+// releases the lock. Lock is not re-entrant: code holding the lock must not
+// try to take it again.
+func Lock(path string) (unlock func(), err error) {
+	lockPath := path + ".lock"
+	ctx, cancel := context.WithTimeout(context.Background(), LockTimeout)
+	defer cancel()
+
+	sem := semaphore(lockPath)
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out waiting to lock %s: %w", path, ctx.Err())
+	}
+
+	if err := os.MkdirAll(filepath.Dir(lockPath), 0o700); err != nil {
+		<-sem
+		return nil, err
+	}
+
+	fl := flock.New(lockPath, flock.SetPermissions(0o600))
+	locked, err := fl.TryLockContext(ctx, lockRetryDelay)
+	if err != nil || !locked {
+		<-sem
+		if err == nil {
+			err = ctx.Err()
+		}
+		return nil, fmt.Errorf("timed out waiting for another pc process to release %s: %w", path, err)
+	}
+	log.Trace().Str("path", lockPath).Msg("Acquired file lock")
+
+	return func() {
+		if err := fl.Unlock(); err != nil {
+			log.Error().Err(err).Str("path", lockPath).Msg("Failed to release file lock")
+		}
+		<-sem
+		log.Trace().Str("path", lockPath).Msg("Released file lock")
+	}, nil
+}
+
+// WriteFile atomically replaces path with data by writing to a temporary file
+// in the same directory and renaming it into place.
+func WriteFile(path string, data []byte, perm os.FileMode) (err error) {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,101 @@
+package safefile
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/flock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFile_ReplacesContentsWithPermissions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("old"), 0o644))
+
+	require.NoError(t, WriteFile(path, []byte("new"), 0o600))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(data))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "temporary file should be renamed into place")
+}
+
+func TestLock_SerializesGoroutines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.yaml")
+
+	var (
+		wg      sync.WaitGroup
+		holders int
+		maxSeen int
+		mu      sync.Mutex
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock, err := Lock(path)
+			if !assert.NoError(t, err) {
+				return
+			}
+			mu.Lock()
+			holders++
+			maxSeen = max(maxSeen, holders)
+			mu.Unlock()
+			time.Sleep(5 * time.Millisecond)
+			mu.Lock()
+			holders--
+			mu.Unlock()
+			unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, maxSeen)
+}
+
+func TestLock_TimesOutWhileHeldElsewhere(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	other := flock.New(path + ".lock")
+	locked, err := other.TryLock()
+	require.NoError(t, err)
+	require.True(t, locked)
+	defer other.Unlock()
+
+	orig := LockTimeout
+	LockTimeout = 50 * time.Millisecond
+	defer func() { LockTimeout = orig }()
+
+	_, err = Lock(path)
+	assert.Error(t, err)
+}
+
+func TestLock_WaitsForRelease(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	other := flock.New(path + ".lock")
+	locked, err := other.TryLock()
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		time.Sleep(50 * time.Millisecond)
+		other.Unlock()
+	}()
+
+	unlock, err := Lock(path)
+	require.NoError(t, err)
+	unlock()
+	wg.Wait()
+}
@@ -0,0 +1,138 @@
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/term"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secretstore"
+)
+
+// PassphraseEnvVar unlocks the encrypted-file backend without prompting.
+const PassphraseEnvVar = "PINECONE_SECRETS_PASSPHRASE"
+
+// promptedPassphrase caches a passphrase entered interactively so it can be
+// handed to the detached login daemon, which has no terminal to prompt on.
+var promptedPassphrase string
+
+// EncryptedFilePath is where the encrypted-file backend stores secrets.
+func EncryptedFilePath() string {
+	return filepath.Join(configuration.NewConfigLocations().ConfigPath, "secrets.yaml.age")
+}
+
+// OpenBackend returns the named secrets backend. The "file" backend reads and
+// writes the plaintext secrets.yaml.
+func OpenBackend(name string) (configuration.Backend, error) {
+	if err := secretstore.ValidateBackendName(name); err != nil {
+		return nil, err
+	}
+
+	switch name {
+	case secretstore.BackendKeyring:
+		return secretstore.NewKeyring(propertyKeys()), nil
+	case secretstore.BackendEncryptedFile:
+		return secretstore.NewEncryptedFile(EncryptedFilePath(), config.SecretsAgeIdentity.Get(), passphrase), nil
+	default:
+		return configuration.FileBackend{Path: ConfigFile.Path()}, nil
+	}
+}
+
+// BackendName returns the name of the secrets backend in use.
+func BackendName() string {
+	return config.SecretsBackend.Get()
+}
+
+// DaemonEnv returns environment variables a detached child process needs to
+// unlock the secrets backend, in the form expected by exec.Cmd.Env.
+func DaemonEnv() []string {
+	// Reading a secret unlocks the backend, prompting for the passphrase now
+	// if it hasn't been entered yet; the daemon has no terminal to prompt on.
+	_ = DefaultAPIKey.Get()
+	if promptedPassphrase == "" {
+		return nil
+	}
+	return []string{PassphraseEnvVar + "=" + promptedPassphrase}
+}
+
+func propertyKeys() []string {
+	return []string{
+		DefaultAPIKey.KeyName,
+		ClientId.KeyName,
+		ClientSecret.KeyName,
+		oAuth2Token.KeyName,
+		ManagedAPIKeys.KeyName,
+	}
+}
+
+// passphrase returns the passphrase for the encrypted-file backend from the
+// environment, or prompts for it on a terminal. A new file requires the
+// passphrase to be entered twice.
+func passphrase() (string, error) {
+	if p := os.Getenv(PassphraseEnvVar); p != "" {
+		return p, nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("the encrypted secrets file is locked; set %s or configure an age identity with 'pc config set secrets-age-identity <path>'", PassphraseEnvVar)
+	}
+
+	fmt.Fprint(os.Stderr, "Passphrase for encrypted secrets: ")
+	entered, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("failed to read passphrase: %w", err)
+	}
+
+	if _, err := os.Stat(EncryptedFilePath()); errors.Is(err, os.ErrNotExist) {
+		fmt.Fprint(os.Stderr, "Confirm passphrase: ")
+		confirmed, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		if string(confirmed) != string(entered) {
+			return "", fmt.Errorf("passphrases do not match")
+		}
+	}
+
+	promptedPassphrase = string(entered)
+	return promptedPassphrase, nil
+}
+
+// Migrate copies every secret from src to dst and verifies dst holds the same
+// values afterwards. It returns the number of non-empty secrets copied. src is
+// left untouched.
+func Migrate(src, dst configuration.Backend) (int, error) {
+	settings, err := src.Load()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read secrets from %s: %w", src.Name(), err)
+	}
+
+	if err := dst.Save(settings); err != nil {
+		return 0, fmt.Errorf("failed to write secrets to %s: %w", dst.Name(), err)
+	}
+
+	written, err := dst.Load()
+	if err != nil {
+		return 0, fmt.Errorf("failed to verify secrets in %s: %w", dst.Name(), err)
+	}
+
+	count := 0
+	for key, value := range settings {
+		want := fmt.Sprint(value)
+		if value == nil || want == "" {
+			continue
+		}
+		if got := fmt.Sprint(written[key]); got != want {
+			return 0, fmt.Errorf("failed to verify secrets in %s: %s does not match", dst.Name(), key)
+		}
+		count++
+	}
+	return count, nil
+}
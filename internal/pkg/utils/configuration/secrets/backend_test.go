@@ -0,0 +1,40 @@
+package secrets
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate_CopiesAndVerifiesSecrets(t *testing.T) {
+	dir := t.TempDir()
+	src := configuration.FileBackend{Path: filepath.Join(dir, "src.yaml")}
+	dst := configuration.FileBackend{Path: filepath.Join(dir, "dst.yaml")}
+	require.NoError(t, src.Save(map[string]any{
+		"api_key":      "pcsk_secret",
+		"client_id":    "",
+		"oauth2_token": `{"access_token":"abc"}`,
+	}))
+
+	count, err := Migrate(src, dst)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, count, "empty values should not be counted")
+	migrated, err := dst.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "pcsk_secret", migrated["api_key"])
+
+	// The source is left untouched for the caller to clear
+	original, err := src.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "pcsk_secret", original["api_key"])
+}
+
+func TestOpenBackend_RejectsUnknownBackend(t *testing.T) {
+	_, err := OpenBackend("vault")
+
+	assert.Error(t, err)
+}
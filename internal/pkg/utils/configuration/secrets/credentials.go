@@ -0,0 +1,76 @@
+package secrets
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/credhelper"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+)
+
+// Credentials returned by the credential helper are held in memory for the
+// life of the process and never written to secrets.yaml.
+var (
+	helperOnce  sync.Once
+	helperCreds *credhelper.Credentials
+)
+
+// UsingCredentialHelper reports whether a credential helper is configured, in
+// which case it is the only source of the API key and service account
+// credentials.
+func UsingCredentialHelper() bool {
+	return config.CredentialHelper.Get() != ""
+}
+
+// helperCredentials runs the configured credential helper once per process.
+// It returns nil when no helper is configured.
+func helperCredentials() *credhelper.Credentials {
+	if !UsingCredentialHelper() {
+		return nil
+	}
+	helperOnce.Do(func() {
+		org, _ := state.ResolveTargetOrg()
+		proj, _ := state.ResolveTargetProject()
+		req := credhelper.Request{
+			Environment:    config.GetEnvironment(),
+			OrganizationId: org.Id,
+			ProjectId:      proj.Id,
+		}
+		creds, err := credhelper.Get(context.Background(), config.CredentialHelper.Get(), req)
+		if err != nil {
+			exit.Error(err, "Error getting credentials from credential helper")
+		}
+		helperCreds = creds
+	})
+	return helperCreds
+}
+
+// GetDefaultAPIKey returns the API key from the credential helper if one is
+// configured, otherwise from secrets.yaml or PINECONE_API_KEY.
+func GetDefaultAPIKey() string {
+	if creds := helperCredentials(); creds != nil {
+		return creds.APIKey
+	}
+	return DefaultAPIKey.Get()
+}
+
+// GetClientId returns the service account client ID from the credential
+// helper if one is configured, otherwise from secrets.yaml or PINECONE_CLIENT_ID.
+func GetClientId() string {
+	if creds := helperCredentials(); creds != nil {
+		return creds.ClientId
+	}
+	return ClientId.Get()
+}
+
+// GetClientSecret returns the service account client secret from the
+// credential helper if one is configured, otherwise from secrets.yaml or
+// PINECONE_CLIENT_SECRET.
+func GetClientSecret() string {
+	if creds := helperCredentials(); creds != nil {
+		return creds.ClientSecret
+	}
+	return ClientSecret.Get()
+}
@@ -2,8 +2,11 @@ package secrets
 
 import (
 	"sync"
+	"time"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secretstore"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
 	"github.com/spf13/viper"
 	"golang.org/x/oauth2"
 )
@@ -58,6 +61,15 @@ var ConfigFile = configuration.ConfigFile{
 }
 
 func init() {
+	name := BackendName()
+	backend, err := OpenBackend(name)
+	if err != nil {
+		exit.Error(err, "Error configuring secrets backend")
+	}
+	// The plaintext file is persisted by viper itself
+	if name != secretstore.BackendFile {
+		ConfigFile.Backend = backend
+	}
 	ConfigFile.Init()
 
 	// Bind environment variables to their associated properties
@@ -115,25 +127,21 @@ func SetProjectManagedKey(managedKey ManagedKey) {
 	managedKeysMu.Lock()
 	defer managedKeysMu.Unlock()
 
-	keys := ManagedAPIKeys.Get()
-	if keys == nil {
-		keys = map[string]ManagedKey{}
-	}
-
-	keys[managedKey.ProjectId] = managedKey
-	ManagedAPIKeys.Set(keys)
+	ManagedAPIKeys.Update(func(keys *map[string]ManagedKey) {
+		if *keys == nil {
+			*keys = map[string]ManagedKey{}
+		}
+		(*keys)[managedKey.ProjectId] = managedKey
+	})
 }
 
 func DeleteProjectManagedKey(projectId string) {
 	managedKeysMu.Lock()
 	defer managedKeysMu.Unlock()
 
-	keys := ManagedAPIKeys.Get()
-	if keys == nil {
-		return
-	}
-	delete(keys, projectId)
-	ManagedAPIKeys.Set(keys)
+	ManagedAPIKeys.Update(func(keys *map[string]ManagedKey) {
+		delete(*keys, projectId)
+	})
 }
 
 func ClearManagedProjectKeys() {
@@ -159,4 +167,7 @@ type ManagedKey struct {
 	ProjectId      string           `json:"project_id,omitempty"`
 	ProjectName    string           `json:"project_name,omitempty"`
 	OrganizationId string           `json:"organization_id,omitempty"`
+	// CreatedAt is when the key was stored. It is zero for keys stored by
+	// versions of the CLI that did not record it.
+	CreatedAt time.Time `json:"created_at,omitzero"`
 }
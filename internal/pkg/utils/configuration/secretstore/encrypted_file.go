@@ -0,0 +1,164 @@
+package secretstore
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/safefile"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/spf13/viper"
+)
+
+// EncryptedFile stores settings as YAML encrypted with age
+// (https://age-encryption.org). The file is protected either by an age
+// identity file (X25519 key) or by a passphrase.
+type EncryptedFile struct {
+	Path string
+	// IdentityFile is the path to an age identity file. When set, it is used
+	// instead of a passphrase.
+	IdentityFile string
+	// Passphrase is called at most once, the first time the file is read or
+	// written, when no IdentityFile is configured.
+	Passphrase func() (string, error)
+
+	// scryptWorkFactor overrides age's default scrypt work factor; tests lower it.
+	scryptWorkFactor int
+
+	identity  age.Identity
+	recipient age.Recipient
+}
+
+func NewEncryptedFile(path, identityFile string, passphrase func() (string, error)) *EncryptedFile {
+	return &EncryptedFile{Path: path, IdentityFile: identityFile, Passphrase: passphrase}
+}
+
+func (e *EncryptedFile) Name() string {
+	return BackendEncryptedFile
+}
+
+func (e *EncryptedFile) Load() (map[string]any, error) {
+	ciphertext, err := os.ReadFile(e.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]any{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", e.Path, err)
+	}
+
+	if err := e.initKeys(); err != nil {
+		return nil, err
+	}
+	r, err := age.Decrypt(bytes.NewReader(ciphertext), e.identity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", e.Path, err)
+	}
+	plaintext, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s: %w", e.Path, err)
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	if err := v.ReadConfig(bytes.NewReader(plaintext)); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", e.Path, err)
+	}
+	log.Debug().Str("path", e.Path).Msg("Loaded secrets from encrypted file")
+	return v.AllSettings(), nil
+}
+
+func (e *EncryptedFile) Save(settings map[string]any) error {
+	if err := e.initKeys(); err != nil {
+		return err
+	}
+
+	v := viper.New()
+	v.SetConfigType("yaml")
+	for key, value := range settings {
+		v.Set(key, value)
+	}
+	var plaintext bytes.Buffer
+	if err := v.WriteConfigTo(&plaintext); err != nil {
+		return err
+	}
+
+	var ciphertext bytes.Buffer
+	w, err := age.Encrypt(&ciphertext, e.recipient)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secrets: %w", err)
+	}
+	if _, err := w.Write(plaintext.Bytes()); err != nil {
+		return fmt.Errorf("failed to encrypt secrets: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to encrypt secrets: %w", err)
+	}
+
+	return safefile.WriteFile(e.Path, ciphertext.Bytes(), 0o600)
+}
+
+func (e *EncryptedFile) Clear() error {
+	err := os.Remove(e.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// initKeys resolves the identity and recipient from the identity file or the
+// passphrase, once per backend.
+func (e *EncryptedFile) initKeys() error {
+	if e.identity != nil {
+		return nil
+	}
+
+	if e.IdentityFile != "" {
+		f, err := os.Open(e.IdentityFile)
+		if err != nil {
+			return fmt.Errorf("failed to open age identity file: %w", err)
+		}
+		defer f.Close()
+		identities, err := age.ParseIdentities(f)
+		if err != nil {
+			return fmt.Errorf("failed to parse age identity file %s: %w", e.IdentityFile, err)
+		}
+		for _, identity := range identities {
+			if x25519, ok := identity.(*age.X25519Identity); ok {
+				e.identity = x25519
+				e.recipient = x25519.Recipient()
+				return nil
+			}
+		}
+		return fmt.Errorf("age identity file %s contains no X25519 identity", e.IdentityFile)
+	}
+
+	if e.Passphrase == nil {
+		return fmt.Errorf("no passphrase or age identity file configured for the encrypted secrets file")
+	}
+	passphrase, err := e.Passphrase()
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(passphrase) == "" {
+		return fmt.Errorf("the passphrase for the encrypted secrets file cannot be empty")
+	}
+
+	identity, err := age.NewScryptIdentity(passphrase)
+	if err != nil {
+		return err
+	}
+	recipient, err := age.NewScryptRecipient(passphrase)
+	if err != nil {
+		return err
+	}
+	if e.scryptWorkFactor > 0 {
+		recipient.SetWorkFactor(e.scryptWorkFactor)
+	}
+	e.identity = identity
+	e.recipient = recipient
+	return nil
+}
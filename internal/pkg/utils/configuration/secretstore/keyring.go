@@ -0,0 +1,80 @@
+package secretstore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/zalando/go-keyring"
+)
+
+// KeyringService is the service name secrets are stored under in the platform keyring.
+const KeyringService = "pinecone-cli"
+
+// Keyring stores each setting as a separate item in the platform keyring:
+// the macOS Keychain, the Secret Service API (GNOME Keyring, KWallet) on Linux,
+// or the Windows Credential Manager.
+type Keyring struct {
+	// Service is the keyring service name; defaults to KeyringService.
+	Service string
+	// Keys are the settings persisted by the backend. The keyring cannot be
+	// enumerated portably, so only these keys are read, written, and cleared.
+	Keys []string
+}
+
+func NewKeyring(keys []string) *Keyring {
+	return &Keyring{Service: KeyringService, Keys: keys}
+}
+
+func (k *Keyring) Name() string {
+	return BackendKeyring
+}
+
+func (k *Keyring) Load() (map[string]any, error) {
+	settings := map[string]any{}
+	for _, key := range k.Keys {
+		value, err := keyring.Get(k.Service, key)
+		if errors.Is(err, keyring.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s from the keyring: %w", key, err)
+		}
+		settings[key] = value
+	}
+	log.Debug().Str("service", k.Service).Int("count", len(settings)).Msg("Loaded secrets from keyring")
+	return settings, nil
+}
+
+func (k *Keyring) Save(settings map[string]any) error {
+	for _, key := range k.Keys {
+		value := stringify(settings[key])
+		if value == "" {
+			if err := k.delete(key); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := keyring.Set(k.Service, key, value); err != nil {
+			return fmt.Errorf("failed to write %s to the keyring: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (k *Keyring) Clear() error {
+	for _, key := range k.Keys {
+		if err := k.delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k *Keyring) delete(key string) error {
+	err := keyring.Delete(k.Service, key)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("failed to delete %s from the keyring: %w", key, err)
+	}
+	return nil
+}
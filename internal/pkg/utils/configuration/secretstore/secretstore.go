@@ -0,0 +1,42 @@
+// Package secretstore provides configuration.Backend implementations for
+// storing CLI secrets outside the plaintext secrets.yaml file: the platform
+// keyring (macOS Keychain, Secret Service on Linux, Windows Credential Manager)
+// and an age-encrypted file protected by a passphrase or an age identity.
+package secretstore
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	BackendFile          = "file"
+	BackendKeyring       = "keyring"
+	BackendEncryptedFile = "encrypted-file"
+)
+
+// BackendNames lists the supported secret storage backends.
+var BackendNames = []string{BackendFile, BackendKeyring, BackendEncryptedFile}
+
+// ValidateBackendName returns an error if name is not a supported backend.
+func ValidateBackendName(name string) error {
+	for _, valid := range BackendNames {
+		if name == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid secrets backend %q; must be one of: %s", name, strings.Join(BackendNames, ", "))
+}
+
+// stringify converts a persisted setting to the string form backends store.
+// Every secrets property is either a string or a JSON-marshaled string.
+func stringify(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,140 @@
+package secretstore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+// testWorkFactor keeps scrypt fast in tests; age's default takes about a second.
+const testWorkFactor = 10
+
+func staticPassphrase(p string) func() (string, error) {
+	return func() (string, error) { return p, nil }
+}
+
+func newTestEncryptedFile(path, passphrase string) *EncryptedFile {
+	e := NewEncryptedFile(path, "", staticPassphrase(passphrase))
+	e.scryptWorkFactor = testWorkFactor
+	return e
+}
+
+func TestValidateBackendName(t *testing.T) {
+	for _, name := range BackendNames {
+		assert.NoError(t, ValidateBackendName(name))
+	}
+	err := ValidateBackendName("vault")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "keyring")
+}
+
+func TestEncryptedFile_RoundTripWithPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml.age")
+	settings := map[string]any{"api_key": "pcsk_secret", "client_id": ""}
+
+	require.NoError(t, newTestEncryptedFile(path, "correct horse").Save(settings))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "pcsk_secret", "secrets must not be stored in plaintext")
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err := newTestEncryptedFile(path, "correct horse").Load()
+	require.NoError(t, err)
+	assert.Equal(t, "pcsk_secret", loaded["api_key"])
+}
+
+func TestEncryptedFile_WrongPassphrase(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml.age")
+	require.NoError(t, newTestEncryptedFile(path, "correct horse").Save(map[string]any{"api_key": "x"}))
+
+	_, err := newTestEncryptedFile(path, "battery staple").Load()
+
+	assert.Error(t, err)
+}
+
+func TestEncryptedFile_EmptyPassphraseRejected(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml.age")
+
+	err := newTestEncryptedFile(path, "  ").Save(map[string]any{"api_key": "x"})
+
+	assert.Error(t, err)
+}
+
+func TestEncryptedFile_RoundTripWithIdentityFile(t *testing.T) {
+	dir := t.TempDir()
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	identityPath := filepath.Join(dir, "key.txt")
+	require.NoError(t, os.WriteFile(identityPath, []byte("# test key\n"+identity.String()+"\n"), 0o600))
+	path := filepath.Join(dir, "secrets.yaml.age")
+
+	noPassphrase := func() (string, error) {
+		t.Fatal("passphrase should not be requested when an identity file is configured")
+		return "", nil
+	}
+	require.NoError(t, NewEncryptedFile(path, identityPath, noPassphrase).Save(map[string]any{"client_secret": "s3cret"}))
+
+	loaded, err := NewEncryptedFile(path, identityPath, noPassphrase).Load()
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret", loaded["client_secret"])
+}
+
+func TestEncryptedFile_LoadMissingFileIsEmpty(t *testing.T) {
+	e := NewEncryptedFile(filepath.Join(t.TempDir(), "missing.age"), "", nil)
+
+	loaded, err := e.Load()
+
+	require.NoError(t, err)
+	assert.Empty(t, loaded)
+}
+
+func TestEncryptedFile_Clear(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "secrets.yaml.age")
+	e := newTestEncryptedFile(path, "pw")
+	require.NoError(t, e.Save(map[string]any{"api_key": "x"}))
+
+	require.NoError(t, e.Clear())
+	require.NoError(t, e.Clear(), "clearing twice should not fail")
+
+	_, err := os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestKeyring_SaveLoadClear(t *testing.T) {
+	keyring.MockInit()
+	k := NewKeyring([]string{"api_key", "client_id", "oauth2_token"})
+
+	require.NoError(t, k.Save(map[string]any{
+		"api_key":      "pcsk_secret",
+		"client_id":    "",
+		"oauth2_token": `{"access_token":"abc"}`,
+		"unknown_key":  "ignored",
+	}))
+
+	loaded, err := k.Load()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"api_key":      "pcsk_secret",
+		"oauth2_token": `{"access_token":"abc"}`,
+	}, loaded)
+
+	// Saving an empty value removes the item
+	require.NoError(t, k.Save(map[string]any{"api_key": ""}))
+	loaded, err = k.Load()
+	require.NoError(t, err)
+	assert.NotContains(t, loaded, "api_key")
+
+	require.NoError(t, k.Clear())
+	loaded, err = k.Load()
+	require.NoError(t, err)
+	assert.Empty(t, loaded)
+}
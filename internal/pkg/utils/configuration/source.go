@@ -0,0 +1,19 @@
+package configuration
+
+// Source describes where an effective setting was resolved from. Settings are
+// resolved in order of precedence: environment variables, the per-directory
+// project file (.pinecone.yaml), and finally the global config and state files
+// under the config directory. Flags such as --index-name only override a
+// setting for the command they're passed to, so they aren't a Source.
+type Source string
+
+const (
+	SourceNone        Source = ""
+	SourceEnv         Source = "env"
+	SourceProjectFile Source = "project file"
+	SourceGlobal      Source = "global"
+
+	// SourceCredentialHelper marks credentials obtained from the configured
+	// credential helper rather than the secrets file or environment.
+	SourceCredentialHelper Source = "credential helper"
+)
@@ -1,6 +1,11 @@
 package state
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/projectfile"
+)
 
 type TargetOrganization struct {
 	Name string `json:"name"`
@@ -26,35 +31,106 @@ type TargetUser struct {
 	Email       string      `json:"email"`
 }
 
+// TargetSources records where each effective target value was resolved from.
+type TargetSources struct {
+	Organization configuration.Source `json:"organization,omitempty"`
+	Project      configuration.Source `json:"project,omitempty"`
+	IndexName    configuration.Source `json:"index_name,omitempty"`
+	Namespace    configuration.Source `json:"namespace,omitempty"`
+}
+
 type TargetContext struct {
 	Project       TargetProject      `json:"project"`
 	Organization  TargetOrganization `json:"organization"`
 	User          TargetUser         `json:"user"`
 	DefaultAPIKey string             `json:"default_api_key"`
+	IndexName     string             `json:"index_name,omitempty"`
+	Namespace     string             `json:"namespace,omitempty"`
+	ProjectFile   string             `json:"project_file,omitempty"`
+	Sources       TargetSources      `json:"sources"`
 }
 
+// GetTargetContext returns the effective target context, taking any project
+// file (.pinecone.yaml) in the working directory into account.
 func GetTargetContext() *TargetContext {
-	return &TargetContext{
-		Organization: TargetOrg.Get(),
-		Project:      TargetProj.Get(),
+	org, orgSource := ResolveTargetOrg()
+	proj, projSource := ResolveTargetProject()
+
+	context := &TargetContext{
+		Organization: org,
+		Project:      proj,
 		User:         AuthedUser.Get(),
+		Sources: TargetSources{
+			Organization: orgSource,
+			Project:      projSource,
+		},
+	}
+
+	if pf := projectfile.Load(); pf != nil {
+		context.ProjectFile = pf.Path
+		if pf.IndexName != "" {
+			context.IndexName = pf.IndexName
+			context.Sources.IndexName = configuration.SourceProjectFile
+		}
+		if pf.Namespace != "" {
+			context.Namespace = pf.Namespace
+			context.Sources.Namespace = configuration.SourceProjectFile
+		}
+	}
+
+	return context
+}
+
+// ResolveTargetOrg returns the effective target organization and where it came from.
+// An organization pinned by the project file takes precedence over the global state.
+// The name is only known when the pinned ID matches the globally targeted organization.
+func ResolveTargetOrg() (TargetOrganization, configuration.Source) {
+	global := TargetOrg.Get()
+	if pf := projectfile.Load(); pf != nil && pf.OrganizationId != "" {
+		org := TargetOrganization{Id: pf.OrganizationId}
+		if global.Id == pf.OrganizationId {
+			org.Name = global.Name
+		}
+		return org, configuration.SourceProjectFile
+	}
+	if global.Id == "" {
+		return global, configuration.SourceNone
+	}
+	return global, configuration.SourceGlobal
+}
+
+// ResolveTargetProject returns the effective target project and where it came from.
+// A project pinned by the project file takes precedence over the global state.
+// The name is only known when the pinned ID matches the globally targeted project.
+func ResolveTargetProject() (TargetProject, configuration.Source) {
+	global := TargetProj.Get()
+	if pf := projectfile.Load(); pf != nil && pf.ProjectId != "" {
+		proj := TargetProject{Id: pf.ProjectId}
+		if global.Id == pf.ProjectId {
+			proj.Name = global.Name
+		}
+		return proj, configuration.SourceProjectFile
+	}
+	if global.Id == "" {
+		return global, configuration.SourceNone
 	}
+	return global, configuration.SourceGlobal
 }
 
 func GetTargetOrgId() (string, error) {
-	orgId := TargetOrg.Get().Id
-	if orgId == "" {
+	org, _ := ResolveTargetOrg()
+	if org.Id == "" {
 		return "", fmt.Errorf("no target organization set")
 	}
-	return orgId, nil
+	return org.Id, nil
 }
 
 func GetTargetProjectId() (string, error) {
-	projId := TargetProj.Get().Id
-	if projId == "" {
+	proj, _ := ResolveTargetProject()
+	if proj.Id == "" {
 		return "", fmt.Errorf("no target project set")
 	}
-	return projId, nil
+	return proj.Id, nil
 }
 
 func GetTargetUserAuthContext() (string, error) {
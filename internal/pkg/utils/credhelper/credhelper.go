@@ -0,0 +1,100 @@
+// Package credhelper implements the external credential helper protocol.
+//
+// Like git and docker credential helpers, a credential helper is an executable
+// the CLI runs to obtain credentials instead of reading them from disk or the
+// environment, so they can live in Vault, 1Password, or any other store. The
+// CLI runs `<helper> get`, writes a JSON Request to its stdin, and reads a
+// JSON Credentials object from its stdout. A non-zero exit status is treated
+// as a failure and anything written to stderr is included in the error.
+package credhelper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+)
+
+// NamePrefix is prepended to helper names that are not found on PATH as-is,
+// so "vault" resolves to a "pinecone-credential-vault" executable.
+const NamePrefix = "pinecone-credential-"
+
+// DefaultTimeout bounds how long a helper may run, since it may block on
+// unlocking a vault or talking to a remote service.
+const DefaultTimeout = 30 * time.Second
+
+// Request is written to the helper's stdin as JSON, giving it the context the
+// CLI is operating in so it can return credentials for the right project.
+type Request struct {
+	Environment    string `json:"environment"`
+	OrganizationId string `json:"organization_id,omitempty"`
+	ProjectId      string `json:"project_id,omitempty"`
+}
+
+// Credentials is read from the helper's stdout as JSON. Every field is
+// optional; a helper typically returns either an API key or a service
+// account's client ID and secret.
+type Credentials struct {
+	APIKey       string `json:"api_key,omitempty"`
+	ClientId     string `json:"client_id,omitempty"`
+	ClientSecret string `json:"client_secret,omitempty"`
+}
+
+// Resolve returns the path of the helper executable. name may be a path, the
+// name of an executable on PATH, or a short name that resolves to a
+// pinecone-credential-<name> executable on PATH.
+func Resolve(name string) (string, error) {
+	if path, err := exec.LookPath(name); err == nil {
+		return path, nil
+	}
+	if !strings.ContainsAny(name, `/\`) {
+		if path, err := exec.LookPath(NamePrefix + name); err == nil {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("credential helper %q not found: it must be a path to an executable or the name of an executable on PATH (optionally without the %q prefix)", name, NamePrefix)
+}
+
+// Get runs the helper and returns the credentials it prints.
+func Get(ctx context.Context, helper string, req Request) (*Credentials, error) {
+	path, err := Resolve(helper)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, path, "get")
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Debug().Str("helper", path).Msg("Running credential helper")
+	if err := cmd.Run(); err != nil {
+		if detail := strings.TrimSpace(stderr.String()); detail != "" {
+			return nil, fmt.Errorf("credential helper %s failed: %w: %s", helper, err, detail)
+		}
+		return nil, fmt.Errorf("credential helper %s failed: %w", helper, err)
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return nil, fmt.Errorf("credential helper %s returned invalid JSON: %w", helper, err)
+	}
+	if creds.APIKey == "" && (creds.ClientId == "" || creds.ClientSecret == "") {
+		return nil, fmt.Errorf("credential helper %s returned no API key or service account credentials", helper)
+	}
+	return &creds, nil
+}
@@ -0,0 +1,87 @@
+package credhelper
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeStub creates an executable shell script standing in for a real helper.
+func writeStub(t *testing.T, dir, name, body string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("stub credential helpers are shell scripts")
+	}
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755))
+	return path
+}
+
+func TestGet_ReturnsCredentialsAndSendsRequest(t *testing.T) {
+	dir := t.TempDir()
+	requestPath := filepath.Join(dir, "request.json")
+	helper := writeStub(t, dir, "helper", `
+[ "$1" = "get" ] || exit 3
+cat > `+requestPath+`
+echo '{"client_id":"id-123","client_secret":"secret-456"}'
+`)
+
+	creds, err := Get(context.Background(), helper, Request{Environment: "production", ProjectId: "proj-1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{ClientId: "id-123", ClientSecret: "secret-456"}, creds)
+	request, err := os.ReadFile(requestPath)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"environment":"production","project_id":"proj-1"}`, string(request))
+}
+
+func TestGet_ResolvesPrefixedNameOnPath(t *testing.T) {
+	dir := t.TempDir()
+	writeStub(t, dir, NamePrefix+"vault", `echo '{"api_key":"pcsk_from_vault"}'`)
+	t.Setenv("PATH", dir)
+
+	creds, err := Get(context.Background(), "vault", Request{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pcsk_from_vault", creds.APIKey)
+}
+
+func TestGet_NonZeroExitIncludesStderr(t *testing.T) {
+	helper := writeStub(t, t.TempDir(), "helper", `echo "vault is sealed" >&2; exit 1`)
+
+	_, err := Get(context.Background(), helper, Request{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vault is sealed")
+}
+
+func TestGet_InvalidJSON(t *testing.T) {
+	helper := writeStub(t, t.TempDir(), "helper", `echo "not json"`)
+
+	_, err := Get(context.Background(), helper, Request{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid JSON")
+}
+
+func TestGet_EmptyCredentials(t *testing.T) {
+	helper := writeStub(t, t.TempDir(), "helper", `echo '{"client_id":"id-only"}'`)
+
+	_, err := Get(context.Background(), helper, Request{})
+
+	assert.Error(t, err)
+}
+
+func TestResolve_NotFound(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := Resolve("missing")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), NamePrefix)
+}
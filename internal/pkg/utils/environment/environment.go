@@ -2,6 +2,9 @@ package environment
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
 )
 
 type EnvironmentConnectionSettings struct {
@@ -11,6 +14,22 @@ type EnvironmentConnectionSettings struct {
 	Auth0ClientId string
 	Auth0URL      string
 	Auth0Audience string
+
+	// IndexHost, when set, overrides the host returned for every index.
+	// "{index}" is replaced with the index name.
+	IndexHost string
+}
+
+// SupportsLogin reports whether users can log in to the environment. Custom
+// environments without an Auth0 URL only accept API keys.
+func (s EnvironmentConnectionSettings) SupportsLogin() bool {
+	return s.Auth0URL != "" && s.Auth0ClientId != ""
+}
+
+// IndexHostFor returns the IndexHost override for indexName, or "" when the
+// environment doesn't override index hosts.
+func (s EnvironmentConnectionSettings) IndexHostFor(indexName string) string {
+	return strings.ReplaceAll(s.IndexHost, "{index}", indexName)
 }
 
 var (
@@ -42,5 +61,16 @@ func GetEnvConfig(env string) (EnvironmentConnectionSettings, error) {
 		return Staging, nil
 	}
 
+	if custom, ok := config.CustomEnvironmentByName(env); ok {
+		return EnvironmentConnectionSettings{
+			DashboardUrl:   custom.DashboardURL,
+			PineconeGCPURL: custom.APIURL,
+			Auth0ClientId:  custom.Auth0ClientId,
+			Auth0URL:       custom.Auth0URL,
+			Auth0Audience:  custom.Auth0Audience,
+			IndexHost:      custom.IndexHost,
+		}, nil
+	}
+
 	return EnvironmentConnectionSettings{}, fmt.Errorf("unknown environment configured: %s", env)
 }
@@ -3,6 +3,7 @@ package exit
 import (
 	"os"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
 	"github.com/pinecone-io/cli/internal/pkg/utils/log"
 )
 
@@ -44,6 +45,12 @@ func Successf(format string, args ...any) {
 	exitHandler.Exit(0)
 }
 
+// Code exits with code, for commands that pass on the exit status of a process
+// they ran, such as a plugin.
+func Code(code int) {
+	exitHandler.Exit(code)
+}
+
 func ErrorMsg(msg string) {
 	log.Error().Msg(msg)
 	exitHandler.Exit(1)
@@ -54,13 +61,15 @@ func ErrorMsgf(format string, args ...any) {
 	exitHandler.Exit(1)
 }
 
+// Error logs msg and exits with the code for err's classification; see
+// clierr.Code.ExitCode.
 func Error(err error, msg string) {
 	if err != nil {
 		log.Error().Err(err).Msg(msg)
 	} else {
 		log.Error().Msg(msg)
 	}
-	exitHandler.Exit(1)
+	exitHandler.Exit(errorExitCode(err))
 }
 
 func Errorf(err error, format string, args ...any) {
@@ -69,5 +78,12 @@ func Errorf(err error, format string, args ...any) {
 	} else {
 		log.Error().Msgf(format, args...)
 	}
-	exitHandler.Exit(1)
+	exitHandler.Exit(errorExitCode(err))
+}
+
+func errorExitCode(err error) int {
+	if err == nil {
+		return clierr.ExitGeneric
+	}
+	return clierr.ExitCodeFor(err)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
 	"github.com/pinecone-io/cli/internal/pkg/utils/log"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -233,3 +234,18 @@ func TestConvenienceFunctions(t *testing.T) {
 		})
 	}
 }
+
+func TestError_ExitsWithClassifiedCode(t *testing.T) {
+	mockHandler := &MockExitHandler{}
+	setExitHandler(mockHandler)
+	defer resetExitHandler()
+
+	restore, _ := withCapturedLogs(t)
+	defer restore()
+
+	Error(clierr.New(clierr.CodeNotFound, "index missing"), "failed to describe index")
+	assert.Equal(t, clierr.ExitNotFound, mockHandler.LastExitCode)
+
+	Errorf(clierr.New(clierr.CodeUnauthenticated, "not logged in"), "failed %s", "auth")
+	assert.Equal(t, clierr.ExitAuth, mockHandler.LastExitCode)
+}
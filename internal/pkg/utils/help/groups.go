@@ -38,6 +38,14 @@ var (
 		ID:    "index-namespace",
 		Title: style.Heading("Index Namespace Commands"),
 	}
+	GROUP_ALIASES = &cobra.Group{
+		ID:    "aliases",
+		Title: style.Heading("Aliases"),
+	}
+	GROUP_PLUGINS = &cobra.Group{
+		ID:    "plugins",
+		Title: style.Heading("Plugins"),
+	}
 	GROUP_INDEX_MANAGEMENT = &cobra.Group{
 		ID:    "index-management",
 		Title: style.Heading("Index Management Commands"),
@@ -0,0 +1,85 @@
+// Package local configures the CLI to talk to Pinecone Local, the emulator
+// container used for development and CI. Pinecone Local needs no login,
+// accepts any API key, and serves its control and data APIs over plaintext.
+package local
+
+import (
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+const (
+	// EnvVar holds the emulator's control plane address. Setting it turns on
+	// local mode without --local.
+	EnvVar = "PINECONE_LOCAL_HOST"
+	// DefaultHost is where Pinecone Local listens by default.
+	DefaultHost = "http://localhost:5080"
+	// APIKey is sent to the emulator, which accepts any key.
+	APIKey = "pclocal"
+)
+
+var forced bool
+
+// Enable turns on local mode, as with --local.
+func Enable() {
+	forced = true
+}
+
+// Enabled reports whether commands should target Pinecone Local.
+func Enabled() bool {
+	return forced || os.Getenv(EnvVar) != ""
+}
+
+// Host returns the control plane URL of the emulator, from PINECONE_LOCAL_HOST
+// or DefaultHost. A host without a scheme is given http://.
+func Host() string {
+	host := strings.TrimRight(os.Getenv(EnvVar), "/")
+	if host == "" {
+		return DefaultHost
+	}
+	if !strings.Contains(host, "://") {
+		host = "http://" + host
+	}
+	return host
+}
+
+// DataHost returns the plaintext URL for an index whose described host is
+// indexHost. Pinecone Local reports index hosts as seen from inside its
+// container, so the port is kept and the hostname is taken from Host; that way
+// an emulator reached as e.g. pinecone:5080 in CI also works for data calls.
+func DataHost(indexHost string) string {
+	described := indexHost
+	if !strings.Contains(described, "://") {
+		described = "http://" + described
+	}
+	du, err := url.Parse(described)
+	if err != nil || du.Port() == "" {
+		return "http://" + strings.TrimPrefix(strings.TrimPrefix(indexHost, "https://"), "http://")
+	}
+	cu, err := url.Parse(Host())
+	if err != nil || cu.Hostname() == "" {
+		return "http://" + du.Host
+	}
+	return "http://" + net.JoinHostPort(cu.Hostname(), du.Port())
+}
+
+// Transport downgrades requests to plain HTTP. The SDK always builds data
+// plane REST URLs with https://, which the emulator doesn't serve.
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Scheme == "https" {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = "http"
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,59 @@
+package local
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHost(t *testing.T) {
+	t.Setenv(EnvVar, "")
+	assert.Equal(t, DefaultHost, Host())
+
+	t.Setenv(EnvVar, "pinecone:5080/")
+	assert.Equal(t, "http://pinecone:5080", Host())
+
+	t.Setenv(EnvVar, "http://127.0.0.1:6000")
+	assert.Equal(t, "http://127.0.0.1:6000", Host())
+}
+
+func TestEnabled(t *testing.T) {
+	t.Setenv(EnvVar, "")
+	forced = false
+	t.Cleanup(func() { forced = false })
+	assert.False(t, Enabled())
+
+	t.Setenv(EnvVar, "localhost:5080")
+	assert.True(t, Enabled())
+
+	t.Setenv(EnvVar, "")
+	Enable()
+	assert.True(t, Enabled())
+}
+
+func TestDataHost(t *testing.T) {
+	t.Setenv(EnvVar, "")
+	assert.Equal(t, "http://localhost:5081", DataHost("localhost:5081"))
+	assert.Equal(t, "http://localhost:5082", DataHost("https://localhost:5082"))
+
+	t.Setenv(EnvVar, "http://pinecone:5080")
+	assert.Equal(t, "http://pinecone:5081", DataHost("localhost:5081"))
+	assert.Equal(t, "http://index-host", DataHost("index-host"))
+}
+
+func TestTransportDowngradesHTTPS(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &Transport{}}
+	resp, err := client.Get(strings.Replace(srv.URL, "http://", "https://", 1))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
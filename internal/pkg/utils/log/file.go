@@ -0,0 +1,113 @@
+package log
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// FileName is the name of the active log file in the logs directory.
+	FileName = "pc.log"
+	// MaxFileBytes is the size at which the log file is rotated.
+	MaxFileBytes = 5 * 1024 * 1024
+	// MaxBackups is how many rotated files (pc.log.1 being the newest) are kept.
+	MaxBackups = 3
+)
+
+// RotatingFile is an append-only log file that is rotated by size. Several pc
+// processes, such as a command and the detached login daemon, may append to
+// the same file; each write is a single append so lines don't interleave.
+type RotatingFile struct {
+	mu   sync.Mutex
+	dir  string
+	f    *os.File
+	size int64
+}
+
+// OpenRotatingFile opens FileName in dir for appending, creating dir if needed.
+func OpenRotatingFile(dir string) (*RotatingFile, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	r := &RotatingFile{dir: dir}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(filepath.Join(r.dir, FileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f, r.size = f, info.Size()
+	return nil
+}
+
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return 0, os.ErrClosed
+	}
+	if r.size > 0 && r.size+int64(len(p)) > MaxFileBytes {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotate shifts pc.log.N to pc.log.N+1, dropping the oldest, moves the active
+// file to pc.log.1 and starts a new one. Another process may have rotated the
+// file already; missing files are skipped.
+func (r *RotatingFile) rotate() error {
+	r.f.Close()
+	r.f = nil
+	base := filepath.Join(r.dir, FileName)
+	os.Remove(fmt.Sprintf("%s.%d", base, MaxBackups))
+	for i := MaxBackups - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", base, i), fmt.Sprintf("%s.%d", base, i+1))
+	}
+	os.Rename(base, base+".1")
+	return r.open()
+}
+
+// Close closes the file.
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
+
+// Files returns the log files in dir that exist, oldest first: the rotated
+// backups followed by the active file.
+func Files(dir string) []string {
+	var files []string
+	base := filepath.Join(dir, FileName)
+	for i := MaxBackups; i >= 1; i-- {
+		path := fmt.Sprintf("%s.%d", base, i)
+		if _, err := os.Stat(path); err == nil {
+			files = append(files, path)
+		}
+	}
+	if _, err := os.Stat(base); err == nil {
+		files = append(files, base)
+	}
+	return files
+}
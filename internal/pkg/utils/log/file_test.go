@@ -0,0 +1,73 @@
+package log
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile_RotatesBySize(t *testing.T) {
+	dir := t.TempDir()
+	f, err := OpenRotatingFile(dir)
+	require.NoError(t, err)
+	defer f.Close()
+
+	line := []byte(strings.Repeat("x", 1024*1024-1) + "\n")
+	for i := 0; i < 5*(MaxBackups+2); i++ {
+		_, err := f.Write(line)
+		require.NoError(t, err)
+	}
+
+	files := Files(dir)
+	require.Len(t, files, MaxBackups+1)
+	assert.Equal(t, filepath.Join(dir, FileName+".3"), files[0])
+	assert.Equal(t, filepath.Join(dir, FileName), files[len(files)-1])
+	for _, path := range files {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(MaxFileBytes))
+	}
+}
+
+func TestFiles_NoLogs(t *testing.T) {
+	assert.Empty(t, Files(t.TempDir()))
+}
+
+func TestEnableFile_WritesJSONLines(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, EnableFile(dir, zerolog.InfoLevel))
+	defer EnableFile(dir, zerolog.Disabled)
+
+	Debug().Msg("not logged")
+	Info().Str("command", "pc index list").Msg("running command")
+
+	data, err := os.ReadFile(filepath.Join(dir, FileName))
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"level":"info"`)
+	assert.Contains(t, lines[0], `"command":"pc index list"`)
+	assert.Contains(t, lines[0], `"pid":`)
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, name := range Levels {
+		_, err := ParseLevel(name)
+		assert.NoError(t, err, name)
+	}
+	level, err := ParseLevel("DEBUG")
+	require.NoError(t, err)
+	assert.Equal(t, zerolog.DebugLevel, level)
+
+	level, err = ParseLevel("")
+	require.NoError(t, err)
+	assert.Equal(t, zerolog.Disabled, level)
+
+	_, err = ParseLevel("verbose")
+	assert.Error(t, err)
+}
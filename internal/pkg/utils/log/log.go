@@ -1,30 +1,116 @@
 package log
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/rs/zerolog"
 	zl "github.com/rs/zerolog/log"
 )
 
+// Levels lists the values accepted by ParseLevel, from quietest to noisiest.
+var Levels = []string{"off", "error", "warn", "info", "debug", "trace"}
+
+var (
+	mu           sync.Mutex
+	consoleLevel = zerolog.Disabled
+	fileLevel    = zerolog.Disabled
+	file         *RotatingFile
+)
+
 func init() {
-	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-	zerolog.SetGlobalLevel(zerolog.Disabled)
+	zerolog.TimeFieldFormat = time.RFC3339Nano
 	if os.Getenv("PINECONE_LOG_LEVEL") == "INFO" {
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
+		consoleLevel = zerolog.InfoLevel
 	}
 	if os.Getenv("PINECONE_LOG_LEVEL") == "DEBUG" {
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
+		consoleLevel = zerolog.DebugLevel
 	}
 	if os.Getenv("PINECONE_LOG_LEVEL") == "TRACE" {
-		zerolog.SetGlobalLevel(zerolog.TraceLevel)
+		consoleLevel = zerolog.TraceLevel
 	}
 	zerolog.CallerMarshalFunc = func(pc uintptr, file string, line int) string {
 		return filepath.Base(file) + ":" + strconv.Itoa(line)
 	}
-	zl.Logger = zl.With().Caller().Logger().Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	configure()
+}
+
+// configure rebuilds the global logger from the console and file levels. The
+// console gets human-readable output on stderr; the log file, when enabled,
+// gets one JSON object per line.
+func configure() {
+	console := zerolog.ConsoleWriter{Out: os.Stderr, FieldsExclude: []string{"pid"}}
+	writers := []io.Writer{&zerolog.FilteredLevelWriter{
+		Writer: zerolog.LevelWriterAdapter{Writer: console},
+		Level:  consoleLevel,
+	}}
+	level := consoleLevel
+	if file != nil && fileLevel != zerolog.Disabled {
+		writers = append(writers, &zerolog.FilteredLevelWriter{
+			Writer: zerolog.LevelWriterAdapter{Writer: file},
+			Level:  fileLevel,
+		})
+		level = min(level, fileLevel)
+	}
+	zerolog.SetGlobalLevel(level)
+	zl.Logger = zerolog.New(zerolog.MultiLevelWriter(writers...)).With().Timestamp().Caller().Int("pid", os.Getpid()).Logger()
+}
+
+// SetConsoleLevel sets the lowest level written to stderr, e.g. for --debug.
+func SetConsoleLevel(level zerolog.Level) {
+	mu.Lock()
+	defer mu.Unlock()
+	consoleLevel = level
+	configure()
+}
+
+// EnableFile starts writing entries at level and above to FileName in dir,
+// rotating it when it grows past MaxFileBytes. A level of zerolog.Disabled
+// turns the log file off.
+func EnableFile(dir string, level zerolog.Level) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if file != nil {
+		file.Close()
+		file = nil
+	}
+	fileLevel = level
+	if level != zerolog.Disabled {
+		f, err := OpenRotatingFile(dir)
+		if err != nil {
+			configure()
+			return err
+		}
+		file = f
+	}
+	configure()
+	return nil
+}
+
+// ParseLevel parses one of Levels. "off" and "" map to zerolog.Disabled.
+func ParseLevel(s string) (zerolog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "", "off":
+		return zerolog.Disabled, nil
+	case "error":
+		return zerolog.ErrorLevel, nil
+	case "warn":
+		return zerolog.WarnLevel, nil
+	case "info":
+		return zerolog.InfoLevel, nil
+	case "debug":
+		return zerolog.DebugLevel, nil
+	case "trace":
+		return zerolog.TraceLevel, nil
+	default:
+		return zerolog.Disabled, fmt.Errorf("invalid log level %q; must be one of: %s", s, strings.Join(Levels, ", "))
+	}
 }
 
 func Logger() *zerolog.Logger {
@@ -43,6 +129,10 @@ func Info() *zerolog.Event {
 	return Logger().Info()
 }
 
+func Warn() *zerolog.Event {
+	return Logger().Warn()
+}
+
 func Error() *zerolog.Event {
 	return Logger().Error()
 }
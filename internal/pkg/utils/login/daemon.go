@@ -19,6 +19,7 @@ import (
 func RunDaemon(sessionId string) {
 	ctx, cancel := context.WithTimeout(context.Background(), sessionMaxAge)
 	defer cancel()
+	log.Info().Str("session_id", sessionId).Msg("daemon: waiting for authorization")
 
 	sess, err := ReadSessionState(sessionId)
 	if err != nil {
@@ -79,6 +80,7 @@ func RunDaemon(sessionId string) {
 		Email:       claims.Email,
 	})
 
+	log.Info().Str("session_id", sessionId).Str("email", claims.Email).Msg("daemon: login complete")
 	_ = WriteSessionResult(SessionResult{
 		SessionId:   sessionId,
 		Status:      "success",
@@ -87,6 +89,7 @@ func RunDaemon(sessionId string) {
 }
 
 func writeDaemonError(sessionId, errMsg string) {
+	log.Error().Str("session_id", sessionId).Msg("daemon: " + errMsg)
 	_ = WriteSessionResult(SessionResult{
 		SessionId:   sessionId,
 		Status:      "error",
@@ -0,0 +1,101 @@
+package login
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/oauth"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"golang.org/x/oauth2"
+)
+
+// getAndSetAccessTokenDevice acquires an OAuth token with the device
+// authorization grant and stores it. The user opens the verification URL on any
+// device and enters the user code while this process polls the token endpoint,
+// so it works where the browser callback on localhost can't be reached, such as
+// over SSH or inside a container.
+//
+// JSON mode prints a {"status":"pending",...} document with the URL and code as
+// soon as they are known, blocks until the user approves, then prints the
+// {"status":"authenticated",...} document.
+func getAndSetAccessTokenDevice(ctx context.Context, orgId, ssoConnection *string, jsonOutput bool) error {
+	a := oauth.Auth{}
+
+	// As in the interactive flow, don't let the root command's --timeout cut off
+	// a user who is still approving the request on another device.
+	apiCtx, apiCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	da, err := a.StartDeviceAuth(apiCtx, orgId, ssoConnection)
+	apiCancel()
+	if err != nil {
+		return fmt.Errorf("error starting device authorization: %w", err)
+	}
+
+	if jsonOutput {
+		printDevicePendingJSON(da)
+	} else {
+		fmt.Fprintf(os.Stderr, "Visit %s and enter the code %s to authorize the CLI.\n", style.Underline(da.VerificationURI), style.Emphasis(da.UserCode))
+		if da.VerificationURIComplete != "" {
+			fmt.Fprintf(os.Stderr, "Or open %s to skip entering the code.\n", style.Underline(da.VerificationURIComplete))
+		}
+		fmt.Fprintln(os.Stderr, "\nWaiting for authorization...")
+	}
+
+	pollCtx, pollCancel := context.WithTimeout(context.Background(), sessionMaxAge)
+	defer pollCancel()
+	token, err := a.PollDeviceToken(pollCtx, da)
+	if err != nil {
+		return fmt.Errorf("error retrieving oauth2 access token with device code: %w", err)
+	}
+
+	claims, err := oauth.ParseClaimsUnverified(token)
+	if err != nil {
+		return fmt.Errorf("error parsing claims from access token: %w", err)
+	}
+	storeUserToken(token, claims)
+
+	// ssoConnection being non-nil means we're already in the SSO round; skip.
+	if ssoConnection == nil {
+		ssoCtx, ssoCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		conn := ResolveSSOConnection(ssoCtx, claims.OrgId)
+		ssoCancel()
+		if conn != nil {
+			if !jsonOutput {
+				fmt.Fprintf(os.Stderr, "\nSSO is required for your organization. Re-authenticating with your identity provider...\n")
+			}
+			oauth.Logout()
+			return getAndSetAccessTokenDevice(ctx, &claims.OrgId, conn, jsonOutput)
+		}
+	}
+
+	if jsonOutput {
+		setupCtx, setupCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer setupCancel()
+		return RunPostAuthSetup(setupCtx)
+	}
+	return nil
+}
+
+func printDevicePendingJSON(da *oauth2.DeviceAuthResponse) {
+	var expiresAt string
+	if !da.Expiry.IsZero() {
+		expiresAt = da.Expiry.UTC().Format(time.RFC3339)
+	}
+	fmt.Fprintln(os.Stdout, text.IndentJSON(struct {
+		Status                  string `json:"status"`
+		VerificationURI         string `json:"verification_uri"`
+		VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+		UserCode                string `json:"user_code"`
+		ExpiresAt               string `json:"expires_at,omitempty"`
+		Description             string `json:"description"`
+	}{
+		Status:                  "pending",
+		VerificationURI:         da.VerificationURI,
+		VerificationURIComplete: da.VerificationURIComplete,
+		UserCode:                da.UserCode,
+		ExpiresAt:               expiresAt,
+		Description:             "Navigate to the verification URI and enter the user code to complete authorization. This command waits and prints the authenticated status when done.",
+	}))
+}
@@ -14,9 +14,11 @@ import (
 	"os/exec"
 	"time"
 
+	"golang.org/x/oauth2"
 	"golang.org/x/term"
 
 	"github.com/pinecone-io/cli/internal/pkg/utils/browser"
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
@@ -51,6 +53,10 @@ type Options struct {
 	// Callers that hold a valid token before clearing credentials (e.g. pc target)
 	// should resolve this with FetchSSOConnection before logout, then pass it here.
 	SSOConnection *string
+	// Device uses the OAuth device authorization grant instead of the browser
+	// callback flow: the user opens a URL and enters a code on any device, so no
+	// local listener or background daemon is needed.
+	Device bool
 }
 
 func Run(ctx context.Context, opts Options) {
@@ -60,7 +66,7 @@ func Run(ctx context.Context, opts Options) {
 	// In JSON mode, check for a pending session once here. If found, skip the
 	// already_authenticated guard and pass the result directly into GetAndSetAccessToken
 	// to avoid a redundant directory scan and TOCTOU window.
-	if opts.Json {
+	if opts.Json && !opts.Device {
 		sess, result, err := findResumableSession()
 		if err != nil {
 			msg.FailMsg("Error checking for existing auth session: %s", err)
@@ -211,6 +217,9 @@ func GetAndSetAccessToken(ctx context.Context, orgId *string, opts Options) erro
 	// Apply TTY auto-detection here so callers don't have to — if stdout is not
 	// a terminal (agentic context), always use the JSON/daemon path.
 	opts.Json = opts.Json || !term.IsTerminal(int(os.Stdout.Fd()))
+	if opts.Device {
+		return getAndSetAccessTokenDevice(ctx, orgId, opts.SSOConnection, opts.Json)
+	}
 	if opts.Json {
 		return getAndSetAccessTokenJSON(ctx, orgId, opts.Wait, opts.SSOConnection, nil, nil)
 	}
@@ -534,18 +543,7 @@ func getAndSetAccessTokenInteractive(ctx context.Context, orgId, ssoConnection *
 	}
 
 	if token != nil {
-		secrets.SetOAuth2Token(*token)
-		secrets.ClientId.Set("")
-		secrets.ClientSecret.Set("")
-
-		authContext := state.AuthUserToken
-		if state.AuthedUser.Get().AuthContext == state.AuthDefaultAPIKey {
-			authContext = state.AuthDefaultAPIKey
-		}
-		state.AuthedUser.Set(state.TargetUser{
-			AuthContext: authContext,
-			Email:       claims.Email,
-		})
+		storeUserToken(token, claims)
 	}
 
 	// Round 1 — check whether SSO enforcement is needed.
@@ -565,6 +563,23 @@ func getAndSetAccessTokenInteractive(ctx context.Context, orgId, ssoConnection *
 	return nil
 }
 
+// storeUserToken persists a user token obtained by logging in, replacing any
+// service account credentials. An explicit API key keeps priority.
+func storeUserToken(token *oauth2.Token, claims *oauth.MyCustomClaims) {
+	secrets.SetOAuth2Token(*token)
+	secrets.ClientId.Set("")
+	secrets.ClientSecret.Set("")
+
+	authContext := state.AuthUserToken
+	if state.AuthedUser.Get().AuthContext == state.AuthDefaultAPIKey {
+		authContext = state.AuthDefaultAPIKey
+	}
+	state.AuthedUser.Set(state.TargetUser{
+		AuthContext: authContext,
+		Email:       claims.Email,
+	})
+}
+
 // applyAuthContext fetches the user's org/project and writes them to state.
 // It returns the authenticated user's email so callers can use it without a
 // second token fetch. It is the side-effect half of the post-auth setup,
@@ -646,7 +661,8 @@ func RunPostAuthSetup(ctx context.Context) error {
 }
 
 // spawnDaemon starts a detached `pc auth _daemon --session-id <id>` process.
-// The PKCE verifier is passed via environment variable so it never touches disk.
+// The PKCE verifier, and the secrets passphrase if one was entered interactively,
+// are passed via environment variables so they never touch disk.
 func spawnDaemon(sessionId, pkceVerifier string) error {
 	exe, err := os.Executable()
 	if err != nil {
@@ -658,6 +674,7 @@ func spawnDaemon(sessionId, pkceVerifier string) error {
 	cmd.Stdout = nil
 	cmd.Stderr = nil
 	cmd.Env = append(os.Environ(), "PINECONE_PKCE_VERIFIER="+pkceVerifier)
+	cmd.Env = append(cmd.Env, secrets.DaemonEnv()...)
 	return cmd.Start()
 }
 
@@ -743,10 +760,10 @@ func renderHTML(w http.ResponseWriter, htmlTemplate string, data map[string]temp
 //   - No credentials and no session → returns a "not authenticated" error.
 func EnsureAuthenticated(ctx context.Context) error {
 	// Service-account and API key credentials don't use OAuth tokens.
-	if secrets.ClientId.Get() != "" && secrets.ClientSecret.Get() != "" {
+	if secrets.GetClientId() != "" && secrets.GetClientSecret() != "" {
 		return nil
 	}
-	if secrets.DefaultAPIKey.Get() != "" {
+	if secrets.GetDefaultAPIKey() != "" {
 		return nil
 	}
 
@@ -782,18 +799,18 @@ func EnsureAuthenticated(ctx context.Context) error {
 			// Token was expired and there's no pending session to fall back to.
 			return err
 		}
-		return fmt.Errorf("not authenticated. Run %s to log in", style.Code("pc login"))
+		return clierr.New(clierr.CodeUnauthenticated, "not authenticated. Run %s to log in", style.Code("pc login"))
 	}
 
 	if result == nil {
 		// Daemon still running — auth not yet complete.
-		return fmt.Errorf("authentication in progress. Visit the following URL to complete login, then retry:\n\n  %s\n\nOr run %s to check status", sess.AuthURL, style.Code("pc login -j"))
+		return clierr.New(clierr.CodeUnauthenticated, "authentication in progress. Visit the following URL to complete login, then retry:\n\n  %s\n\nOr run %s to check status", sess.AuthURL, style.Code("pc login -j"))
 	}
 
 	// Daemon finished.
 	if result.Status == "error" {
 		defer CleanupSession(sess.SessionId)
-		return fmt.Errorf("authentication failed: %s. Run %s to try again", result.Error, style.Code("pc login"))
+		return clierr.New(clierr.CodeUnauthenticated, "authentication failed: %s. Run %s to try again", result.Error, style.Code("pc login"))
 	}
 
 	// Reload credentials so we can check SSO enforcement before finalising.
@@ -810,7 +827,7 @@ func EnsureAuthenticated(ctx context.Context) error {
 		if token != nil && token.AccessToken != "" {
 			if claims, claimsErr := oauth.ParseClaimsUnverified(token); claimsErr == nil {
 				if ResolveSSOConnection(ctx, claims.OrgId) != nil {
-					return fmt.Errorf("SSO authentication is required for this organization. Run %s to complete authentication", style.Code("pc login"))
+					return clierr.New(clierr.CodeUnauthenticated, "SSO authentication is required for this organization. Run %s to complete authentication", style.Code("pc login"))
 				}
 			}
 		}
@@ -10,6 +10,7 @@ import (
 	"github.com/pinecone-io/cli/internal/pkg/utils/environment"
 	"github.com/pinecone-io/cli/internal/pkg/utils/log"
 	"github.com/pinecone-io/cli/internal/pkg/utils/oauth"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
 )
 
 // dashboardOrg is the subset of the dashboard API org response needed for SSO lookup.
@@ -48,12 +49,12 @@ func FetchSSOConnection(ctx context.Context, orgId string) (string, error) {
 		return "", nil
 	}
 
-	envConfig, err := environment.GetEnvConfig(config.Environment.Get())
+	envConfig, err := environment.GetEnvConfig(config.GetEnvironment())
 	if err != nil {
 		return "", nil
 	}
 
-	return fetchSSOConnectionFromURL(ctx, orgId, token.AccessToken, http.DefaultClient, envConfig.DashboardUrl)
+	return fetchSSOConnectionFromURL(ctx, orgId, token.AccessToken, transport.HTTPClient(), envConfig.DashboardUrl)
 }
 
 // fetchSSOConnectionFromURL is the testable core: it takes an explicit HTTP
@@ -0,0 +1,111 @@
+package mcp
+
+import (
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Schema is a JSON Schema object.
+type Schema map[string]any
+
+var structpbType = reflect.TypeOf(structpb.Struct{})
+
+// SchemaFor derives the JSON Schema of v's type from its json tags, so tool
+// inputs accept exactly what the CLI's --body payloads accept.
+//
+// Embedded structs are flattened, as encoding/json does. A field is required
+// when it has a `jsonschema:"required"` tag, and is described by its
+// `description` tag.
+func SchemaFor(v any) Schema {
+	return schemaForType(reflect.TypeOf(v))
+}
+
+func schemaForType(t reflect.Type) Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	// Metadata and filters are protobuf Structs, which marshal as plain
+	// JSON objects.
+	if t == structpbType {
+		return Schema{"type": "object"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s := Schema{"type": "integer"}
+		if t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64 {
+			s["minimum"] = 0
+		}
+		return s
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		return Schema{"type": "array", "items": schemaForType(t.Elem())}
+	case reflect.Map:
+		s := Schema{"type": "object"}
+		if t.Elem().Kind() != reflect.Interface {
+			s["additionalProperties"] = schemaForType(t.Elem())
+		}
+		return s
+	case reflect.Struct:
+		return structSchema(t)
+	default:
+		// interface{} and anything else: any JSON value
+		return Schema{}
+	}
+}
+
+func structSchema(t reflect.Type) Schema {
+	properties := map[string]any{}
+	var required []string
+	addFields(t, properties, &required)
+
+	s := Schema{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func addFields(t reflect.Type, properties map[string]any, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				addFields(ft, properties, required)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		fs := schemaForType(f.Type)
+		if desc := f.Tag.Get("description"); desc != "" {
+			fs["description"] = desc
+		}
+		properties[name] = fs
+		if strings.Contains(f.Tag.Get("jsonschema"), "required") {
+			*required = append(*required, name)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type embedded struct {
+	Name string `json:"name" jsonschema:"required" description:"The name"`
+}
+
+type sample struct {
+	embedded
+	Count    *uint32            `json:"count,omitempty"`
+	Scores   []float32          `json:"scores"`
+	Labels   map[string]string  `json:"labels"`
+	Extra    map[string]any     `json:"extra"`
+	Metadata *structpb.Struct   `json:"metadata"`
+	Any      any                `json:"any"`
+	Skipped  string             `json:"-"`
+	Nested   *struct{ On bool } `json:"nested"`
+}
+
+func TestSchemaFor(t *testing.T) {
+	s := SchemaFor(sample{})
+
+	assert.Equal(t, "object", s["type"])
+	assert.Equal(t, []string{"name"}, s["required"])
+
+	props := s["properties"].(map[string]any)
+	assert.Equal(t, Schema{"type": "string", "description": "The name"}, props["name"])
+	assert.Equal(t, Schema{"type": "integer", "minimum": 0}, props["count"])
+	assert.Equal(t, Schema{"type": "array", "items": Schema{"type": "number"}}, props["scores"])
+	assert.Equal(t, Schema{"type": "object", "additionalProperties": Schema{"type": "string"}}, props["labels"])
+	assert.Equal(t, Schema{"type": "object"}, props["extra"])
+	assert.Equal(t, Schema{"type": "object"}, props["metadata"])
+	assert.Equal(t, Schema{}, props["any"])
+	assert.Equal(t, Schema{"type": "object", "properties": map[string]any{"On": Schema{"type": "boolean"}}}, props["nested"])
+	assert.NotContains(t, props, "Skipped")
+}
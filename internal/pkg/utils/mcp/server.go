@@ -0,0 +1,265 @@
+// Package mcp implements the subset of the Model Context Protocol that pc mcp
+// serve needs: JSON-RPC 2.0 over newline-delimited stdio, with tools only.
+// See https://modelcontextprotocol.io/specification.
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+)
+
+// LatestProtocolVersion is offered to clients that ask for a version the
+// server doesn't know.
+const LatestProtocolVersion = "2025-06-18"
+
+var supportedProtocolVersions = []string{LatestProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// JSON-RPC error codes.
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// Handler runs a tool call. args holds the raw "arguments" object. A returned
+// error is reported to the client as a tool error, not a protocol error, so
+// the model can see it and correct the call.
+type Handler func(ctx context.Context, args json.RawMessage) (any, error)
+
+// Tool is a tool exposed to clients.
+type Tool struct {
+	Name        string
+	Title       string
+	Description string
+	InputSchema Schema
+	// ReadOnly tools don't modify anything. Destructive tools may overwrite or
+	// remove data.
+	ReadOnly    bool
+	Destructive bool
+	Handler     Handler
+}
+
+// Server answers MCP requests for a fixed set of tools.
+type Server struct {
+	Name         string
+	Version      string
+	Instructions string
+
+	tools []Tool
+}
+
+// NewServer returns a server named name that exposes tools.
+func NewServer(name, version string, tools []Tool) *Server {
+	return &Server{Name: name, Version: version, tools: tools}
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Serve reads requests from r and writes responses to w until r is exhausted
+// or ctx is done. Requests are handled one at a time, in order.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 64*1024*1024)
+		for scanner.Scan() {
+			line := slices.Clone(scanner.Bytes())
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+		readErr <- scanner.Err()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-readErr:
+			return err
+		case line := <-lines:
+			if len(line) == 0 {
+				continue
+			}
+			resp, ok := s.handle(ctx, line)
+			if !ok {
+				continue
+			}
+			if err := enc.Encode(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handle processes one message. It returns false for notifications, which get
+// no response.
+func (s *Server) handle(ctx context.Context, line []byte) (response, bool) {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		return errorResponse(json.RawMessage("null"), codeParseError, "parse error: "+err.Error()), true
+	}
+	if req.ID == nil {
+		log.Debug().Str("method", req.Method).Msg("MCP notification")
+		return response{}, false
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, codeInvalidRequest, "invalid request"), true
+	}
+
+	log.Debug().Str("method", req.Method).Msg("MCP request")
+	switch req.Method {
+	case "initialize":
+		return resultResponse(req.ID, s.initialize(req.Params)), true
+	case "ping":
+		return resultResponse(req.ID, struct{}{}), true
+	case "tools/list":
+		return resultResponse(req.ID, s.listTools()), true
+	case "tools/call":
+		result, err := s.callTool(ctx, req.Params)
+		if err != nil {
+			return errorResponse(req.ID, codeInvalidParams, err.Error()), true
+		}
+		return resultResponse(req.ID, result), true
+	default:
+		return errorResponse(req.ID, codeMethodNotFound, fmt.Sprintf("method not found: %s", req.Method)), true
+	}
+}
+
+func (s *Server) initialize(params json.RawMessage) any {
+	var in struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	_ = json.Unmarshal(params, &in)
+	version := LatestProtocolVersion
+	if slices.Contains(supportedProtocolVersions, in.ProtocolVersion) {
+		version = in.ProtocolVersion
+	}
+
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools": map[string]any{"listChanged": false},
+		},
+		"serverInfo": map[string]any{
+			"name":    s.Name,
+			"version": s.Version,
+		},
+		"instructions": s.Instructions,
+	}
+}
+
+func (s *Server) listTools() any {
+	type annotations struct {
+		Title           string `json:"title,omitempty"`
+		ReadOnlyHint    bool   `json:"readOnlyHint"`
+		DestructiveHint bool   `json:"destructiveHint"`
+	}
+	type tool struct {
+		Name        string      `json:"name"`
+		Title       string      `json:"title,omitempty"`
+		Description string      `json:"description"`
+		InputSchema Schema      `json:"inputSchema"`
+		Annotations annotations `json:"annotations"`
+	}
+
+	tools := make([]tool, 0, len(s.tools))
+	for _, t := range s.tools {
+		tools = append(tools, tool{
+			Name:        t.Name,
+			Title:       t.Title,
+			Description: t.Description,
+			InputSchema: t.InputSchema,
+			Annotations: annotations{Title: t.Title, ReadOnlyHint: t.ReadOnly, DestructiveHint: t.Destructive},
+		})
+	}
+	return map[string]any{"tools": tools}
+}
+
+type toolResult struct {
+	Content []content `json:"content"`
+	IsError bool      `json:"isError,omitempty"`
+}
+
+type content struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (toolResult, error) {
+	var in struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &in); err != nil {
+		return toolResult{}, fmt.Errorf("invalid tools/call params: %w", err)
+	}
+	i := slices.IndexFunc(s.tools, func(t Tool) bool { return t.Name == in.Name })
+	if i < 0 {
+		return toolResult{}, fmt.Errorf("unknown tool: %s", in.Name)
+	}
+	if len(in.Arguments) == 0 || string(in.Arguments) == "null" {
+		in.Arguments = json.RawMessage("{}")
+	}
+
+	out, err := s.tools[i].Handler(ctx, in.Arguments)
+	if err != nil {
+		log.Debug().Err(err).Str("tool", in.Name).Msg("MCP tool call failed")
+		return toolResult{Content: []content{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+	b, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return toolResult{Content: []content{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+	return toolResult{Content: []content{{Type: "text", Text: string(b)}}}, nil
+}
+
+// DecodeArgs unmarshals tool arguments into T, rejecting unknown fields so
+// that a misspelled argument isn't silently ignored.
+func DecodeArgs[T any](args json.RawMessage) (T, error) {
+	var v T
+	dec := json.NewDecoder(bytes.NewReader(args))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil && !errors.Is(err, io.EOF) {
+		return v, fmt.Errorf("invalid arguments: %w", err)
+	}
+	return v, nil
+}
+
+func resultResponse(id json.RawMessage, result any) response {
+	return response{JSONRPC: "2.0", ID: id, Result: result}
+}
+
+func errorResponse(id json.RawMessage, code int, message string) response {
+	return response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: message}}
+}
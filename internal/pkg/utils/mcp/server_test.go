@@ -0,0 +1,116 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, s *Server, requests ...string) []map[string]any {
+	t.Helper()
+	var out bytes.Buffer
+	err := s.Serve(context.Background(), strings.NewReader(strings.Join(requests, "\n")+"\n"), &out)
+	require.NoError(t, err)
+
+	var responses []map[string]any
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var r map[string]any
+		require.NoError(t, dec.Decode(&r))
+		responses = append(responses, r)
+	}
+	return responses
+}
+
+func echoTool() Tool {
+	return Tool{
+		Name:        "echo",
+		Description: "Echo the message",
+		InputSchema: SchemaFor(struct {
+			Message string `json:"message" jsonschema:"required"`
+		}{}),
+		ReadOnly: true,
+		Handler: func(_ context.Context, raw json.RawMessage) (any, error) {
+			args, err := DecodeArgs[struct {
+				Message string `json:"message"`
+			}](raw)
+			if err != nil {
+				return nil, err
+			}
+			if args.Message == "" {
+				return nil, errors.New("message is required")
+			}
+			return map[string]string{"message": args.Message}, nil
+		},
+	}
+}
+
+func TestServeInitialize(t *testing.T) {
+	s := NewServer("test", "1.2.3", nil)
+	responses := serve(t, s,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"initialize","params":{"protocolVersion":"1999-01-01"}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"ping"}`,
+	)
+
+	require.Len(t, responses, 3, "notifications get no response")
+	result := responses[0]["result"].(map[string]any)
+	assert.Equal(t, "2024-11-05", result["protocolVersion"])
+	assert.Equal(t, "1.2.3", result["serverInfo"].(map[string]any)["version"])
+	assert.Equal(t, LatestProtocolVersion, responses[1]["result"].(map[string]any)["protocolVersion"])
+	assert.Equal(t, float64(3), responses[2]["id"])
+}
+
+func TestServeTools(t *testing.T) {
+	s := NewServer("test", "1.2.3", []Tool{echoTool()})
+	responses := serve(t, s,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{"message":"hi"}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"echo","arguments":{}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"echo","arguments":{"msg":"hi"}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"missing"}}`,
+	)
+	require.Len(t, responses, 5)
+
+	tools := responses[0]["result"].(map[string]any)["tools"].([]any)
+	require.Len(t, tools, 1)
+	tool := tools[0].(map[string]any)
+	assert.Equal(t, "echo", tool["name"])
+	assert.Equal(t, []any{"message"}, tool["inputSchema"].(map[string]any)["required"])
+	assert.Equal(t, true, tool["annotations"].(map[string]any)["readOnlyHint"])
+
+	ok := responses[1]["result"].(map[string]any)
+	assert.Nil(t, ok["isError"])
+	assert.JSONEq(t, `{"message":"hi"}`, ok["content"].([]any)[0].(map[string]any)["text"].(string))
+
+	failed := responses[2]["result"].(map[string]any)
+	assert.Equal(t, true, failed["isError"])
+	assert.Contains(t, failed["content"].([]any)[0].(map[string]any)["text"], "message is required")
+
+	unknownField := responses[3]["result"].(map[string]any)
+	assert.Equal(t, true, unknownField["isError"])
+
+	assert.Equal(t, float64(codeInvalidParams), responses[4]["error"].(map[string]any)["code"])
+}
+
+func TestServeErrors(t *testing.T) {
+	s := NewServer("test", "1.2.3", nil)
+	responses := serve(t, s,
+		`not json`,
+		`{"jsonrpc":"2.0","id":"a","method":"resources/list"}`,
+		`{"id":2,"method":"ping"}`,
+	)
+	require.Len(t, responses, 3)
+
+	assert.Equal(t, float64(codeParseError), responses[0]["error"].(map[string]any)["code"])
+	assert.Equal(t, "a", responses[1]["id"])
+	assert.Equal(t, float64(codeMethodNotFound), responses[1]["error"].(map[string]any)["code"])
+	assert.Equal(t, float64(codeInvalidRequest), responses[2]["error"].(map[string]any)["code"])
+}
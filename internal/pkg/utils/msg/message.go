@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
 	"github.com/pinecone-io/cli/internal/pkg/utils/text"
 )
@@ -22,30 +23,56 @@ func FailMsg(format string, a ...any) {
 	fmt.Fprintln(os.Stderr, style.FailMsg(formatted))
 }
 
-// FailJSON emits a structured {"error": "..."} JSON object to stdout when
-// jsonFlag is true, then writes the human-readable error to stderr via
-// FailMsg regardless. This ensures agents capturing stdout in --json mode
-// receive a machine-readable error, while human users still see styled output.
+// FailJSON emits a structured error envelope to stdout when jsonFlag is true,
+// then writes the human-readable error to stderr via FailMsg regardless. This
+// ensures agents capturing stdout in --json mode receive a machine-readable
+// error, while human users still see styled output:
 //
-// ANSI escape sequences are stripped from the JSON error value because callers
+//	{"error": {"code": "not_found", "message": "...", "http_status": 404, "request_id": "...", "retryable": false}}
+//
+// The code, HTTP status and request ID are derived from the first error in a
+// (see clierr.Classify); use FailJSONError when the error isn't an argument.
+//
+// ANSI escape sequences are stripped from the JSON message because callers
 // often pass style.Emphasis/style.Code arguments that are evaluated before this
 // function runs. In a typical --json pipeline (cmd --json | jq .) stderr is
 // still a TTY so colorEnabled() returns true, meaning those arguments already
 // contain escape codes by the time we format the string. Stripping here keeps
 // the JSON value clean regardless of terminal state.
 func FailJSON(jsonFlag bool, format string, a ...any) {
+	var err error
+	for _, arg := range a {
+		if e, ok := arg.(error); ok {
+			err = e
+			break
+		}
+	}
+	FailJSONError(jsonFlag, err, format, a...)
+}
+
+// FailJSONError is FailJSON with the error to classify given explicitly.
+func FailJSONError(jsonFlag bool, err error, format string, a ...any) {
 	if jsonFlag {
-		message := fmt.Sprintf(format, a...)
-		message = ansiEscape.ReplaceAllString(message, "")
-		message = backtickCode.ReplaceAllString(message, "$1")
-		message = strings.TrimSpace(message)
-		fmt.Fprintln(os.Stdout, text.IndentJSON(struct {
-			Error string `json:"error"`
-		}{Error: message}))
+		fmt.Fprintln(os.Stdout, text.IndentJSON(ErrorEnvelope(err, fmt.Sprintf(format, a...))))
 	}
 	FailMsg(format, a...)
 }
 
+// ErrorEnvelope returns the --json error document for err, with message as
+// its human-readable message.
+func ErrorEnvelope(err error, message string) clierr.Envelope {
+	message = ansiEscape.ReplaceAllString(message, "")
+	message = backtickCode.ReplaceAllString(message, "$1")
+	message = strings.TrimSpace(message)
+
+	classified := clierr.Classify(err)
+	if classified == nil {
+		classified = &clierr.Error{Code: clierr.CodeUnknown}
+	}
+	classified.Message = message
+	return clierr.Envelope{Error: classified}
+}
+
 func SuccessMsg(format string, a ...any) {
 	formatted := fmt.Sprintf(format, a...)
 	fmt.Fprintln(os.Stderr, style.SuccessMsg(formatted))
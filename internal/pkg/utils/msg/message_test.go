@@ -2,12 +2,15 @@ package msg
 
 import (
 	"bytes"
+	"encoding/json"
+	"errors"
 	"io"
 	"os"
 	"strings"
 	"testing"
 
 	"github.com/fatih/color"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -223,3 +226,33 @@ func TestFailJSON_NoANSIOnNonTTY(t *testing.T) {
 	out := captureStderr(t, func() { FailMsg("plain error") })
 	assert.NotContains(t, out, "\x1b[")
 }
+
+func TestFailJSON_EnvelopeClassifiesErrorArgument(t *testing.T) {
+	prev := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = prev }()
+
+	var stdout string
+	captureStderr(t, func() {
+		stdout = captureStdout(t, func() {
+			FailJSON(true, "Failed to describe index: %s", &pinecone.PineconeError{Code: 404, Msg: errors.New("not found")})
+		})
+	})
+
+	var envelope struct {
+		Error struct {
+			Code       string  `json:"code"`
+			Message    string  `json:"message"`
+			HTTPStatus *int    `json:"http_status"`
+			RequestID  *string `json:"request_id"`
+			Retryable  bool    `json:"retryable"`
+		} `json:"error"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &envelope))
+	assert.Equal(t, "not_found", envelope.Error.Code)
+	assert.Contains(t, envelope.Error.Message, "Failed to describe index")
+	if assert.NotNil(t, envelope.Error.HTTPStatus) {
+		assert.Equal(t, 404, *envelope.Error.HTTPStatus)
+	}
+	assert.False(t, envelope.Error.Retryable)
+}
@@ -52,7 +52,7 @@ func (a *Auth) ExchangeAuthCode(ctx context.Context, codeVerifier, authCode stri
 	opts := []oauth2.AuthCodeOption{}
 	opts = append(opts, oauth2.SetAuthURLParam("code_verifier", codeVerifier))
 
-	token, err := conf.Exchange(ctx, authCode, opts...)
+	token, err := conf.Exchange(withHTTPClient(ctx), authCode, opts...)
 	if err != nil {
 		return nil, err
 	}
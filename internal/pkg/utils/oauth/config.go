@@ -1,13 +1,27 @@
 package oauth
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
 	"github.com/pinecone-io/cli/internal/pkg/utils/environment"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
 	"golang.org/x/oauth2"
 )
 
+// withHTTPClient makes oauth2 calls on ctx use the CLI's proxy and TLS
+// settings, unless ctx already carries a client.
+func withHTTPClient(ctx context.Context) context.Context {
+	if c, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && c != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, oauth2.HTTPClient, transport.HTTPClient())
+}
+
 func getAudience() (string, error) {
-	connectionConfig, err := environment.GetEnvConfig(config.Environment.Get())
+	connectionConfig, err := environment.GetEnvConfig(config.GetEnvironment())
 	if err != nil {
 		return "", err
 	}
@@ -16,10 +30,13 @@ func getAudience() (string, error) {
 }
 
 func newOauth2Config() (*oauth2.Config, error) {
-	connectionConfig, err := environment.GetEnvConfig(config.Environment.Get())
+	connectionConfig, err := environment.GetEnvConfig(config.GetEnvironment())
 	if err != nil {
 		return nil, err
 	}
+	if !connectionConfig.SupportsLogin() {
+		return nil, fmt.Errorf("environment %q does not support login; use an API key instead (pc auth configure --api-key)", config.GetEnvironment())
+	}
 
 	return &oauth2.Config{
 		ClientID: connectionConfig.Auth0ClientId,
@@ -0,0 +1,56 @@
+package oauth
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+)
+
+// StartDeviceAuth begins the OAuth 2.0 device authorization grant (RFC 8628),
+// returning the verification URL and user code to show the user. It needs no
+// local callback server, so it works over SSH and inside containers.
+func (a *Auth) StartDeviceAuth(ctx context.Context, orgId, ssoConnection *string) (*oauth2.DeviceAuthResponse, error) {
+	conf, err := newOauth2Config()
+	if err != nil {
+		return nil, err
+	}
+	audience, err := getAudience()
+	if err != nil {
+		return nil, err
+	}
+	return startDeviceAuth(ctx, conf, audience, orgId, ssoConnection)
+}
+
+// PollDeviceToken polls the token endpoint at the interval requested by the
+// authorization server until the user approves or denies the request, or the
+// device code expires.
+func (a *Auth) PollDeviceToken(ctx context.Context, da *oauth2.DeviceAuthResponse) (*oauth2.Token, error) {
+	conf, err := newOauth2Config()
+	if err != nil {
+		return nil, err
+	}
+	return pollDeviceToken(ctx, conf, da)
+}
+
+func startDeviceAuth(ctx context.Context, conf *oauth2.Config, audience string, orgId, ssoConnection *string) (*oauth2.DeviceAuthResponse, error) {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("audience", audience),
+		oauth2.SetAuthURLParam("sourceTag", SourceTag),
+	}
+	if orgId != nil && *orgId != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("orgId", *orgId))
+	}
+	if ssoConnection != nil && *ssoConnection != "" {
+		opts = append(opts, oauth2.SetAuthURLParam("connection", *ssoConnection))
+	}
+	return conf.DeviceAuth(withHTTPClient(ctx), opts...)
+}
+
+func pollDeviceToken(ctx context.Context, conf *oauth2.Config, da *oauth2.DeviceAuthResponse) (*oauth2.Token, error) {
+	token, err := conf.DeviceAccessToken(withHTTPClient(ctx), da)
+	if err != nil {
+		return nil, err
+	}
+	LogTokenClaims(token, "Obtained access token with device code")
+	return token, nil
+}
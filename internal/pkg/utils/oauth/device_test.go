@@ -0,0 +1,124 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+// newFakeDeviceAuthServer implements the device code and token endpoints of an
+// authorization server. The token endpoint reports authorization_pending for
+// the first pendingPolls requests, then either issues a token or, if deny is
+// set, reports access_denied.
+func newFakeDeviceAuthServer(t *testing.T, pendingPolls int32, deny bool) (*httptest.Server, *oauth2.Config) {
+	t.Helper()
+	var polls atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/device/code", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+		if got := r.PostForm.Get("audience"); got != "https://api.test" {
+			t.Errorf("expected audience param, got %q", got)
+		}
+		if got := r.PostForm.Get("orgId"); got != "org-1" {
+			t.Errorf("expected orgId param, got %q", got)
+		}
+		if got := r.PostForm.Get("sourceTag"); got != SourceTag {
+			t.Errorf("expected sourceTag param, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"device_code":               "dev-code",
+			"user_code":                 "ABCD-EFGH",
+			"verification_uri":          "https://auth.test/activate",
+			"verification_uri_complete": "https://auth.test/activate?user_code=ABCD-EFGH",
+			"expires_in":                60,
+			"interval":                  1,
+		})
+	})
+	mux.HandleFunc("/oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parse form: %v", err)
+		}
+		if got := r.PostForm.Get("grant_type"); got != "urn:ietf:params:oauth:grant-type:device_code" {
+			t.Errorf("unexpected grant_type %q", got)
+		}
+		if got := r.PostForm.Get("device_code"); got != "dev-code" {
+			t.Errorf("unexpected device_code %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if polls.Add(1) <= pendingPolls {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "authorization_pending"})
+			return
+		}
+		if deny {
+			w.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "access_denied"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access-123",
+			"refresh_token": "refresh-456",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	conf := &oauth2.Config{
+		ClientID: "client-id",
+		Endpoint: oauth2.Endpoint{
+			TokenURL:      server.URL + "/oauth/token",
+			DeviceAuthURL: server.URL + "/oauth/device/code",
+		},
+		Scopes: []string{"openid", "offline_access"},
+	}
+	return server, conf
+}
+
+func TestDeviceAuthGrant_PollsUntilApproved(t *testing.T) {
+	_, conf := newFakeDeviceAuthServer(t, 1, false)
+	ctx := context.Background()
+	orgId := "org-1"
+
+	da, err := startDeviceAuth(ctx, conf, "https://api.test", &orgId, nil)
+	if err != nil {
+		t.Fatalf("startDeviceAuth returned error: %v", err)
+	}
+	if da.UserCode != "ABCD-EFGH" || da.VerificationURIComplete == "" {
+		t.Fatalf("unexpected device auth response: %+v", da)
+	}
+
+	token, err := pollDeviceToken(ctx, conf, da)
+	if err != nil {
+		t.Fatalf("pollDeviceToken returned error: %v", err)
+	}
+	if token.AccessToken != "access-123" || token.RefreshToken != "refresh-456" {
+		t.Errorf("unexpected token: %+v", token)
+	}
+}
+
+func TestDeviceAuthGrant_Denied(t *testing.T) {
+	_, conf := newFakeDeviceAuthServer(t, 0, true)
+	ctx := context.Background()
+	orgId := "org-1"
+
+	da, err := startDeviceAuth(ctx, conf, "https://api.test", &orgId, nil)
+	if err != nil {
+		t.Fatalf("startDeviceAuth returned error: %v", err)
+	}
+
+	if _, err := pollDeviceToken(ctx, conf, da); err == nil {
+		t.Fatal("expected an error when the user denies the request")
+	}
+}
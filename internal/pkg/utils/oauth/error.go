@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
 )
 
 type OAuthErrorCode string
@@ -145,6 +147,19 @@ func (e *TokenError) UserMessage() string {
 	}
 }
 
+// ClassifyError implements clierr.Classifier. Token errors are authentication
+// failures unless the auth server was rate limiting or unavailable.
+func (e *TokenError) ClassifyError() *clierr.Error {
+	c := &clierr.Error{Code: clierr.CodeUnauthenticated, HTTPStatus: e.HTTPStatus}
+	switch e.Kind {
+	case TokenErrRateLimited:
+		c.Code, c.Retryable = clierr.CodeRateLimited, true
+	case TokenErrAuthServerIssue:
+		c.Code, c.Retryable = clierr.CodeUnavailable, true
+	}
+	return c
+}
+
 // Handles custom formatting of TokenError for use with fmt.Printf, fmt.Println, etc
 func (e *TokenError) Format(s fmt.State, verb rune) {
 	if e == nil {
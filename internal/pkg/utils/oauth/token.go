@@ -89,7 +89,7 @@ func (t *TokenManager) Token(ctx context.Context) (*oauth2.Token, error) {
 	if err != nil {
 		return nil, err
 	}
-	newToken, err := refreshTokenWithOrg(ctx, t.cfg, &latestToken, claims.OrgId)
+	newToken, err := refreshTokenWithOrg(withHTTPClient(ctx), t.cfg, &latestToken, claims.OrgId)
 	if err != nil {
 		var te *TokenError
 		if errors.As(err, &te) {
@@ -0,0 +1,107 @@
+// Package plugin finds and runs external pc-<name> executables, which extend
+// the CLI with subcommands that live outside this repository, the way kubectl
+// and gh plugins do.
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
+)
+
+// Prefix is the file name prefix that marks an executable as a pc plugin.
+const Prefix = "pc-"
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*$`)
+
+// Plugin is an executable found on disk; running pc <Name> runs it.
+type Plugin struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+// Dir returns the directory searched for plugins before PATH.
+func Dir() string {
+	return filepath.Join(configuration.ConfigDirPath(), "plugins")
+}
+
+// SearchPath returns the directories searched for plugins, in order: Dir,
+// then the entries of PATH.
+func SearchPath() []string {
+	dirs := []string{Dir()}
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		if dir == "" {
+			dir = "."
+		}
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// Discover returns the plugins in dirs sorted by name. When several
+// directories hold a plugin with the same name, the first one wins.
+func Discover(dirs []string) []Plugin {
+	found := map[string]Plugin{}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name, ok := pluginName(entry.Name())
+			if !ok {
+				continue
+			}
+			if _, seen := found[name]; seen {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			if !isExecutable(path) {
+				continue
+			}
+			found[name] = Plugin{Name: name, Path: path}
+		}
+	}
+
+	plugins := make([]Plugin, 0, len(found))
+	for _, p := range found {
+		plugins = append(plugins, p)
+	}
+	slices.SortFunc(plugins, func(a, b Plugin) int { return strings.Compare(a.Name, b.Name) })
+	return plugins
+}
+
+// pluginName returns the command name for a pc-<name> file.
+func pluginName(file string) (string, bool) {
+	name, ok := strings.CutPrefix(file, Prefix)
+	if !ok {
+		return "", false
+	}
+	if runtime.GOOS == "windows" {
+		ext := strings.ToLower(filepath.Ext(name))
+		if ext != ".exe" && ext != ".bat" && ext != ".cmd" {
+			return "", false
+		}
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	if !validName.MatchString(name) {
+		return "", false
+	}
+	return name, true
+}
+
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		return true
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
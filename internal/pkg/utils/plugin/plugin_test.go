@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func writeFile(t *testing.T, dir, name string, mode os.FileMode) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte("#!/bin/sh\nexit 0\n"), mode); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDiscover(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugins are matched by extension on Windows")
+	}
+	first, second := t.TempDir(), t.TempDir()
+
+	hello := writeFile(t, first, "pc-hello", 0o755)
+	writeFile(t, second, "pc-hello", 0o755)
+	tools := writeFile(t, second, "pc-tools", 0o755)
+	writeFile(t, first, "pc-notexec", 0o644)
+	writeFile(t, first, "pc-", 0o755)
+	writeFile(t, first, "pc-bad.name", 0o755)
+	writeFile(t, first, "kubectl-hello", 0o755)
+	if err := os.Mkdir(filepath.Join(first, "pc-dir"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	got := Discover([]string{first, filepath.Join(first, "missing"), second})
+	want := []Plugin{
+		{Name: "hello", Path: hello},
+		{Name: "tools", Path: tools},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Discover() = %v, want %v", got, want)
+	}
+}
+
+func TestContextEnv(t *testing.T) {
+	c := Context{
+		Environment: "production",
+		ProjectID:   "proj-1",
+		APIKey:      "key",
+	}
+	want := []string{
+		"PC_ENVIRONMENT=production",
+		"PC_PROJECT_ID=proj-1",
+		"PC_API_KEY=key",
+	}
+	if got := c.Env(); !slices.Equal(got, want) {
+		t.Errorf("Env() = %v, want %v", got, want)
+	}
+}
+
+func TestContextSharesAPIKey(t *testing.T) {
+	t.Setenv("PINECONE_PLUGIN_API_KEY", "")
+	if !(Context{}).sharesAPIKey() {
+		t.Error("sharesAPIKey() = false without an access token, want true")
+	}
+	withToken := Context{AccessToken: "token"}
+	if withToken.sharesAPIKey() {
+		t.Error("sharesAPIKey() = true with an access token, want false")
+	}
+
+	t.Setenv("PINECONE_PLUGIN_API_KEY", "true")
+	if !withToken.sharesAPIKey() {
+		t.Error("sharesAPIKey() = false with PINECONE_PLUGIN_API_KEY=true, want true")
+	}
+}
@@ -0,0 +1,130 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/build"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/sdk"
+)
+
+// Context is the CLI state handed to a plugin through PC_* environment
+// variables, so that plugins act on the same target as pc itself.
+type Context struct {
+	Environment string // PC_ENVIRONMENT
+	APIURL      string // PC_API_URL, the control plane base URL
+	OrgID       string // PC_ORG_ID
+	OrgName     string // PC_ORG_NAME
+	ProjectID   string // PC_PROJECT_ID
+	ProjectName string // PC_PROJECT_NAME
+	// AccessToken is a short-lived bearer token from the user login or service
+	// account. Requests to the control and data planes made with it must also
+	// send the project ID in an X-Project-Id header.
+	AccessToken string // PC_ACCESS_TOKEN
+	// APIKey is Pinecone Local's key, or the configured default API key when
+	// there's no AccessToken or the plugin-api-key setting is on.
+	APIKey  string // PC_API_KEY
+	Bin     string // PC_BIN, the path of the running pc executable
+	Version string // PC_VERSION
+}
+
+// ResolveContext gathers the target context and credentials for a plugin.
+// Missing credentials aren't an error: plugins that need them report it.
+func ResolveContext(ctx context.Context) Context {
+	org, _ := state.ResolveTargetOrg()
+	project, _ := state.ResolveTargetProject()
+	c := Context{
+		Environment: config.GetEnvironment(),
+		APIURL:      sdk.APIHostURL(),
+		OrgID:       org.Id,
+		OrgName:     org.Name,
+		ProjectID:   project.Id,
+		ProjectName: project.Name,
+		Version:     build.Version,
+	}
+	if bin, err := os.Executable(); err == nil {
+		c.Bin = bin
+	}
+
+	if local.Enabled() {
+		c.APIURL = local.Host()
+		c.APIKey = local.APIKey
+		return c
+	}
+
+	// The admin headers carry the bearer token even when an API key is set,
+	// so plugins that manage projects can use it.
+	if h, err := sdk.APIAuthHeaders(ctx, true); err == nil {
+		c.AccessToken = strings.TrimPrefix(h.Get("Authorization"), "Bearer ")
+	} else {
+		log.Debug().Err(err).Msg("No access token for plugin")
+	}
+	if c.sharesAPIKey() {
+		if h, err := sdk.APIAuthHeaders(ctx, false); err == nil {
+			c.APIKey = h.Get("Api-Key")
+		}
+	}
+	return c
+}
+
+// sharesAPIKey reports whether the default API key is passed to plugins. It
+// doesn't expire, so plugins only get it when they have no token to use
+// instead, or the user opted in with the plugin-api-key setting.
+func (c Context) sharesAPIKey() bool {
+	return c.AccessToken == "" || config.PluginAPIKeyEnabled()
+}
+
+// Env returns c as PC_* environment variables, leaving out empty values.
+func (c Context) Env() []string {
+	vars := []struct{ name, value string }{
+		{"PC_ENVIRONMENT", c.Environment},
+		{"PC_API_URL", c.APIURL},
+		{"PC_ORG_ID", c.OrgID},
+		{"PC_ORG_NAME", c.OrgName},
+		{"PC_PROJECT_ID", c.ProjectID},
+		{"PC_PROJECT_NAME", c.ProjectName},
+		{"PC_ACCESS_TOKEN", c.AccessToken},
+		{"PC_API_KEY", c.APIKey},
+		{"PC_BIN", c.Bin},
+		{"PC_VERSION", c.Version},
+	}
+	env := make([]string, 0, len(vars))
+	for _, v := range vars {
+		if v.value != "" {
+			env = append(env, v.name+"="+v.value)
+		}
+	}
+	return env
+}
+
+// Run runs p with args, connected to the terminal, and returns its exit code.
+// Interrupts reach the plugin directly since it shares the terminal's process
+// group; pc waits for it to exit.
+func Run(p Plugin, args []string, c Context) (int, error) {
+	cmd := exec.Command(p.Path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), c.Env()...)
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if code := exitErr.ExitCode(); code > 0 {
+			return code, nil
+		}
+		// Killed by a signal
+		return 1, nil
+	}
+	if err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
@@ -23,6 +23,9 @@ func PrintAuthStatus(authStatus AuthStatus) {
 	fmt.Fprintf(writer, "Default API Key\t%s\n", labelUnsetIfEmpty(authStatus.DefaultAPIKey))
 	fmt.Fprintf(writer, "Service Account Client ID\t%s\n", labelUnsetIfEmpty(authStatus.ClientID))
 	fmt.Fprintf(writer, "Service Account Client Secret\t%s\n", labelUnsetIfEmpty(authStatus.ClientSecret))
+	if authStatus.CredentialHelper != "" {
+		fmt.Fprintf(writer, "Credential Helper\t%s\n", authStatus.CredentialHelper)
+	}
 	fmt.Fprintf(writer, "Token Expiry\t%s\n", labelUnsetIfEmpty(authStatus.TokenExpiry))
 	fmt.Fprintf(writer, "Token Time Remaining\t%s\n", labelUnsetIfEmpty(authStatus.TokenTimeRemaining))
 	fmt.Fprintf(writer, "Token Scope\t%s\n", labelUnsetIfEmpty(authStatus.TokenScope))
@@ -36,6 +39,7 @@ type AuthStatus struct {
 	AuthMode            string        `json:"auth_mode,omitempty"`
 	ClientID            string        `json:"client_id,omitempty"`
 	ClientSecret        string        `json:"client_secret,omitempty"`
+	CredentialHelper    string        `json:"credential_helper,omitempty"`
 	Environment         string        `json:"environment,omitempty"`
 	DefaultAPIKey       string        `json:"default_api_key,omitempty"`
 	OrganizationName    string        `json:"organization_name,omitempty"`
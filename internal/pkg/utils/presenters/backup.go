@@ -44,27 +44,27 @@ func PrintBackupTable(backup *pinecone.Backup) {
 	writer.Flush()
 }
 
-func PrintBackupList(list *pinecone.BackupList) {
-	if list == nil || len(list.Data) == 0 {
-		w := NewTabWriter()
-		PrintEmptyState(w, "backups")
-		return
-	}
-
-	cols := []tableColumn{
-		{header: "BACKUP ID"},
-		{header: "NAME"},
-		{header: "INDEX"},
-		{header: "STATUS", colorizer: colorizeBackupStatus},
-		{header: "CLOUD/REGION"},
-		{header: "RECORDS"},
-		{header: "NAMESPACES"},
-		{header: "SIZE (B)"},
-		{header: "CREATED"},
-	}
-	rows := make([][]string, len(list.Data))
-	for i, b := range list.Data {
-		rows[i] = []string{
+// BackupListTable returns the table printed by pc index backup list.
+func BackupListTable(list *pinecone.BackupList) Table {
+	table := Table{Resource: "backups", ListField: "data"}
+	if list == nil {
+		return table
+	}
+
+	table.Columns = []TableColumn{
+		{Header: "BACKUP ID"},
+		{Header: "NAME"},
+		{Header: "INDEX"},
+		{Header: "STATUS", Colorizer: colorizeBackupStatus},
+		{Header: "CLOUD/REGION"},
+		{Header: "RECORDS"},
+		{Header: "NAMESPACES"},
+		{Header: "SIZE (B)"},
+		{Header: "CREATED"},
+	}
+	for _, b := range list.Data {
+		table.Records = append(table.Records, b)
+		table.Rows = append(table.Rows, []string{
 			b.BackupId,
 			DisplayOrNone(b.Name),
 			b.SourceIndexName,
@@ -74,11 +74,16 @@ func PrintBackupList(list *pinecone.BackupList) {
 			DisplayOrNone(b.NamespaceCount),
 			DisplayOrNone(b.SizeBytes),
 			DisplayOrNone(b.CreatedAt),
-		}
+		})
 	}
-	printColorizedTable(cols, rows)
+	return table
+}
+
+// PrintBackupList prints a backup table followed by the next pagination token.
+func PrintBackupList(list *pinecone.BackupList, table Table) {
+	PrintTable(table)
 
-	if list.Pagination != nil && list.Pagination.Next != "" {
+	if list != nil && list.Pagination != nil && list.Pagination.Next != "" {
 		fmt.Printf("\nNext Pagination Token: %s\n", list.Pagination.Next)
 	}
 }
@@ -105,25 +110,25 @@ func PrintRestoreJob(job *pinecone.RestoreJob) {
 	writer.Flush()
 }
 
-func PrintRestoreJobList(list *pinecone.RestoreJobList) {
-	if list == nil || len(list.Data) == 0 {
-		w := NewTabWriter()
-		PrintEmptyState(w, "restore jobs")
-		return
-	}
-
-	cols := []tableColumn{
-		{header: "RESTORE JOB ID"},
-		{header: "BACKUP ID"},
-		{header: "TARGET INDEX"},
-		{header: "STATUS", colorizer: colorizeRestoreJobStatus},
-		{header: "PERCENT"},
-		{header: "CREATED"},
-		{header: "COMPLETED"},
-	}
-	rows := make([][]string, len(list.Data))
-	for i, job := range list.Data {
-		rows[i] = []string{
+// RestoreJobListTable returns the table printed by pc index restore list.
+func RestoreJobListTable(list *pinecone.RestoreJobList) Table {
+	table := Table{Resource: "restore jobs", ListField: "data"}
+	if list == nil {
+		return table
+	}
+
+	table.Columns = []TableColumn{
+		{Header: "RESTORE JOB ID"},
+		{Header: "BACKUP ID"},
+		{Header: "TARGET INDEX"},
+		{Header: "STATUS", Colorizer: colorizeRestoreJobStatus},
+		{Header: "PERCENT"},
+		{Header: "CREATED"},
+		{Header: "COMPLETED"},
+	}
+	for _, job := range list.Data {
+		table.Records = append(table.Records, job)
+		table.Rows = append(table.Rows, []string{
 			job.RestoreJobId,
 			job.BackupId,
 			job.TargetIndexName,
@@ -131,11 +136,17 @@ func PrintRestoreJobList(list *pinecone.RestoreJobList) {
 			DisplayOrNone(job.PercentComplete),
 			formatTime(job.CreatedAt),
 			formatTimePtr(job.CompletedAt),
-		}
+		})
 	}
-	printColorizedTable(cols, rows)
+	return table
+}
+
+// PrintRestoreJobList prints a restore job table followed by the next
+// pagination token.
+func PrintRestoreJobList(list *pinecone.RestoreJobList, table Table) {
+	PrintTable(table)
 
-	if list.Pagination != nil && list.Pagination.Next != "" {
+	if list != nil && list.Pagination != nil && list.Pagination.Next != "" {
 		fmt.Printf("\nNext Pagination Token: %s\n", list.Pagination.Next)
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/bulkimport"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
@@ -46,26 +47,25 @@ func PrintImportTable(imp *pinecone.Import) {
 	writer.Flush()
 }
 
-// PrintImportList prints a table of import operations.
-func PrintImportList(list *pinecone.ListImportsResponse) {
-	if list == nil || len(list.Imports) == 0 {
-		w := NewTabWriter()
-		PrintEmptyState(w, "imports")
-		return
+// ImportListTable returns the table printed by pc index import list.
+func ImportListTable(list *pinecone.ListImportsResponse) Table {
+	table := Table{Resource: "imports", ListField: "imports"}
+	if list == nil {
+		return table
 	}
 
-	cols := []tableColumn{
-		{header: "IMPORT ID"},
-		{header: "STATUS", colorizer: colorizeImportStatus},
-		{header: "URI"},
-		{header: "PERCENT"},
-		{header: "RECORDS"},
-		{header: "CREATED"},
-		{header: "FINISHED"},
+	table.Columns = []TableColumn{
+		{Header: "IMPORT ID"},
+		{Header: "STATUS", Colorizer: colorizeImportStatus},
+		{Header: "URI"},
+		{Header: "PERCENT"},
+		{Header: "RECORDS"},
+		{Header: "CREATED"},
+		{Header: "FINISHED"},
 	}
-	rows := make([][]string, len(list.Imports))
-	for i, imp := range list.Imports {
-		rows[i] = []string{
+	for _, imp := range list.Imports {
+		table.Records = append(table.Records, imp)
+		table.Rows = append(table.Rows, []string{
 			imp.Id,
 			string(imp.Status),
 			imp.Uri,
@@ -73,11 +73,17 @@ func PrintImportList(list *pinecone.ListImportsResponse) {
 			fmt.Sprintf("%d", imp.RecordsImported),
 			formatTimePtr(imp.CreatedAt),
 			formatTimePtr(imp.FinishedAt),
-		}
+		})
 	}
-	printColorizedTable(cols, rows)
+	return table
+}
+
+// PrintImportList prints a table of import operations followed by the next
+// pagination token.
+func PrintImportList(list *pinecone.ListImportsResponse, table Table) {
+	PrintTable(table)
 
-	if list.NextPaginationToken != nil && *list.NextPaginationToken != "" {
+	if list != nil && list.NextPaginationToken != nil && *list.NextPaginationToken != "" {
 		fmt.Printf("\nNext Pagination Token: %s\n", *list.NextPaginationToken)
 	}
 }
@@ -94,3 +100,89 @@ func colorizeImportStatus(status string) string {
 		return status
 	}
 }
+
+// PrintImportValidationReport prints the namespaces found by import data
+// validation, followed by any problems.
+func PrintImportValidationReport(r *bulkimport.Report) {
+	writer := NewTabWriter()
+	if r == nil {
+		PrintEmptyState(writer, "validation report")
+		return
+	}
+
+	fmt.Fprintln(writer, "ATTRIBUTE\tVALUE")
+	fmt.Fprintf(writer, "Location\t%s\n", r.Location)
+	fmt.Fprintf(writer, "Files\t%d\n", r.Files)
+	fmt.Fprintf(writer, "Rows\t%d\n", r.Rows)
+	fmt.Fprintf(writer, "Rows Checked\t%d\n", r.RowsChecked)
+	if r.Dimension > 0 {
+		fmt.Fprintf(writer, "Dimension\t%d\n", r.Dimension)
+	} else {
+		fmt.Fprintf(writer, "Dimension\t%s\n", nonePlaceholder)
+	}
+	writer.Flush()
+
+	if len(r.Namespaces) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(r.Namespaces))
+		for i, ns := range r.Namespaces {
+			rows[i] = []string{ns.Name, fmt.Sprint(ns.Files), fmt.Sprint(ns.Rows)}
+		}
+		printColorizedTable([]TableColumn{{Header: "NAMESPACE"}, {Header: "FILES"}, {Header: "ROWS"}}, rows)
+	}
+
+	if len(r.Problems) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(r.Problems))
+		for i, p := range r.Problems {
+			path := p.Path
+			if path == "" {
+				path = "-"
+			}
+			rows[i] = []string{string(p.Severity), path, p.Message}
+		}
+		printColorizedTable([]TableColumn{{Header: "SEVERITY", Colorizer: ColorizeSeverity}, {Header: "PATH"}, {Header: "PROBLEM"}}, rows)
+	}
+}
+
+// PrintImportManifest prints the files pc index import prepare wrote to dir.
+func PrintImportManifest(dir string, m *bulkimport.Manifest) {
+	writer := NewTabWriter()
+	if m == nil {
+		PrintEmptyState(writer, "import manifest")
+		return
+	}
+
+	fmt.Fprintln(writer, "ATTRIBUTE\tVALUE")
+	fmt.Fprintf(writer, "Output\t%s\n", dir)
+	fmt.Fprintf(writer, "Vector Type\t%s\n", m.VectorType)
+	if m.Dimension > 0 {
+		fmt.Fprintf(writer, "Dimension\t%d\n", m.Dimension)
+	} else {
+		fmt.Fprintf(writer, "Dimension\t%s\n", nonePlaceholder)
+	}
+	fmt.Fprintf(writer, "Row Group Size\t%d\n", m.RowGroupSize)
+	fmt.Fprintf(writer, "Files\t%d\n", m.Files)
+	fmt.Fprintf(writer, "Rows\t%d\n", m.Rows)
+	writer.Flush()
+
+	if len(m.Namespaces) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(m.Namespaces))
+		for i, ns := range m.Namespaces {
+			rows[i] = []string{ns.Dir, fmt.Sprint(len(ns.Files)), fmt.Sprint(ns.Rows)}
+		}
+		printColorizedTable([]TableColumn{{Header: "NAMESPACE"}, {Header: "FILES"}, {Header: "ROWS"}}, rows)
+	}
+}
+
+// ColorizeSeverity colors an import validation problem's severity.
+func ColorizeSeverity(severity string) string {
+	switch bulkimport.Severity(severity) {
+	case bulkimport.SeverityError:
+		return style.StatusRed(severity)
+	case bulkimport.SeverityWarning:
+		return style.StatusYellow(severity)
+	}
+	return severity
+}
@@ -0,0 +1,224 @@
+package presenters
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// jsonPath is a parsed -o jsonpath=<expr> expression. It supports the subset
+// of JSONPath that is useful for picking fields out of CLI output:
+//
+//	.host                  a field; applied to an array it selects the field of every element
+//	$.spec.serverless      an optional leading $
+//	.indexes[0].name       an array element; negative indexes count from the end
+//	.data[*].backup_id     every element of an array, or every value of an object
+//	['field-name']         a field whose name isn't a plain identifier
+//
+// A bare path prints each match on its own line. As in kubectl, an expression
+// may instead mix paths in braces with literal text, where {"\t"} is a quoted
+// literal: '{.name}{"\t"}{.host}{"\n"}'. A path in braces prints its matches
+// separated by spaces.
+type jsonPath struct {
+	segments []jsonPathSegment
+	// bare is true when the expression is a single path without braces.
+	bare bool
+}
+
+type jsonPathSegment struct {
+	literal string
+	steps   []jsonPathStep
+	isPath  bool
+}
+
+type jsonPathStep struct {
+	field    string
+	index    int
+	wildcard bool
+	isIndex  bool
+}
+
+func parseJSONPath(expr string) (*jsonPath, error) {
+	expr = strings.TrimSpace(expr)
+	if !strings.Contains(expr, "{") {
+		steps, err := parseJSONPathSteps(expr)
+		if err != nil {
+			return nil, err
+		}
+		return &jsonPath{bare: true, segments: []jsonPathSegment{{isPath: true, steps: steps}}}, nil
+	}
+
+	p := &jsonPath{}
+	rest := expr
+	for rest != "" {
+		open := strings.Index(rest, "{")
+		if open < 0 {
+			p.segments = append(p.segments, jsonPathSegment{literal: rest})
+			break
+		}
+		if open > 0 {
+			p.segments = append(p.segments, jsonPathSegment{literal: rest[:open]})
+		}
+		end := strings.Index(rest[open:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("invalid jsonpath %q: unclosed {", expr)
+		}
+		inner := strings.TrimSpace(rest[open+1 : open+end])
+		rest = rest[open+end+1:]
+
+		if strings.HasPrefix(inner, `"`) {
+			literal, err := strconv.Unquote(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid jsonpath %q: bad literal %s", expr, inner)
+			}
+			p.segments = append(p.segments, jsonPathSegment{literal: literal})
+			continue
+		}
+		steps, err := parseJSONPathSteps(inner)
+		if err != nil {
+			return nil, err
+		}
+		p.segments = append(p.segments, jsonPathSegment{isPath: true, steps: steps})
+	}
+	return p, nil
+}
+
+func parseJSONPathSteps(path string) ([]jsonPathStep, error) {
+	orig := path
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "$"), "@")
+	var steps []jsonPathStep
+	for path != "" {
+		switch {
+		case strings.HasPrefix(path, ".*"):
+			steps = append(steps, jsonPathStep{wildcard: true})
+			path = path[2:]
+		case path[0] == '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid jsonpath %q: empty field name", orig)
+			}
+			steps = append(steps, jsonPathStep{field: path[:end]})
+			path = path[end:]
+		case path[0] == '[':
+			end := strings.Index(path, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid jsonpath %q: unclosed [", orig)
+			}
+			inner := strings.TrimSpace(path[1:end])
+			path = path[end+1:]
+			switch {
+			case inner == "*":
+				steps = append(steps, jsonPathStep{wildcard: true})
+			case strings.HasPrefix(inner, "'") || strings.HasPrefix(inner, `"`):
+				if len(inner) < 2 || inner[len(inner)-1] != inner[0] {
+					return nil, fmt.Errorf("invalid jsonpath %q: bad field %s", orig, inner)
+				}
+				steps = append(steps, jsonPathStep{field: inner[1 : len(inner)-1]})
+			default:
+				n, err := strconv.Atoi(inner)
+				if err != nil {
+					return nil, fmt.Errorf("invalid jsonpath %q: unsupported subscript [%s]", orig, inner)
+				}
+				steps = append(steps, jsonPathStep{index: n, isIndex: true})
+			}
+		default:
+			// Allow a leading field without a dot, e.g. "host"
+			if len(steps) == 0 && orig == path {
+				path = "." + path
+				continue
+			}
+			return nil, fmt.Errorf("invalid jsonpath %q: unexpected %q", orig, path)
+		}
+	}
+	return steps, nil
+}
+
+func (p *jsonPath) execute(value any) (string, error) {
+	var b strings.Builder
+	for _, seg := range p.segments {
+		if !seg.isPath {
+			b.WriteString(seg.literal)
+			continue
+		}
+		matches := evalJSONPath(seg.steps, value)
+		if p.bare {
+			if len(matches) == 0 {
+				return "", fmt.Errorf("jsonpath matched nothing")
+			}
+			for _, m := range matches {
+				b.WriteString(scalarString(m))
+				b.WriteByte('\n')
+			}
+			continue
+		}
+		parts := make([]string, len(matches))
+		for i, m := range matches {
+			parts[i] = scalarString(m)
+		}
+		b.WriteString(strings.Join(parts, " "))
+	}
+	return b.String(), nil
+}
+
+func evalJSONPath(steps []jsonPathStep, root any) []any {
+	nodes := []any{root}
+	for _, step := range steps {
+		var next []any
+		for _, node := range nodes {
+			next = append(next, applyJSONPathStep(step, node)...)
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+func applyJSONPathStep(step jsonPathStep, node any) []any {
+	switch {
+	case step.wildcard:
+		switch v := node.(type) {
+		case []any:
+			return v
+		case map[string]any:
+			keys := make([]string, 0, len(v))
+			for k := range v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			out := make([]any, 0, len(keys))
+			for _, k := range keys {
+				out = append(out, v[k])
+			}
+			return out
+		}
+	case step.isIndex:
+		if arr, ok := node.([]any); ok {
+			i := step.index
+			if i < 0 {
+				i += len(arr)
+			}
+			if i >= 0 && i < len(arr) {
+				return []any{arr[i]}
+			}
+		}
+	default:
+		switch v := node.(type) {
+		case map[string]any:
+			if field, ok := v[step.field]; ok {
+				return []any{field}
+			}
+		case []any:
+			// A field of an array selects that field of each element
+			var out []any
+			for _, elem := range v {
+				out = append(out, applyJSONPathStep(step, elem)...)
+			}
+			return out
+		}
+	}
+	return nil
+}
@@ -0,0 +1,393 @@
+package presenters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/text"
+	"go.yaml.in/yaml/v3"
+)
+
+// Output format names accepted by -o/--output.
+const (
+	OutputTable    = "table"
+	OutputJSON     = "json"
+	OutputYAML     = "yaml"
+	OutputCSV      = "csv"
+	OutputTSV      = "tsv"
+	OutputJSONPath = "jsonpath"
+	OutputTemplate = "template"
+	OutputName     = "name"
+)
+
+// OutputFlagUsage is the help text for the -o/--output flag.
+const OutputFlagUsage = "Output format: table, json, yaml, csv, tsv, name, jsonpath=<expr>, or template=<go-template>"
+
+// OutputFormat is the value of the -o/--output flag. It implements pflag.Value
+// so an invalid format is rejected while flags are parsed. The zero value is
+// the table format.
+type OutputFormat struct {
+	Name string
+	// Expr is the expression of the jsonpath and template formats.
+	Expr string
+}
+
+// ParseOutputFormat parses a format such as "yaml" or "jsonpath={.host}".
+func ParseOutputFormat(value string) (OutputFormat, error) {
+	name, expr, hasExpr := strings.Cut(value, "=")
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch name {
+	case "", OutputTable, OutputJSON, OutputYAML, OutputCSV, OutputTSV, OutputName:
+		if hasExpr {
+			return OutputFormat{}, fmt.Errorf("output format %q does not take an expression", name)
+		}
+		if name == OutputTable {
+			name = ""
+		}
+		return OutputFormat{Name: name}, nil
+	case OutputJSONPath, OutputTemplate:
+		if strings.TrimSpace(expr) == "" {
+			return OutputFormat{}, fmt.Errorf("output format %s requires an expression, e.g. %s={.name}", name, name)
+		}
+		if name == OutputTemplate {
+			if _, err := newOutputTemplate(expr); err != nil {
+				return OutputFormat{}, err
+			}
+		} else if _, err := parseJSONPath(expr); err != nil {
+			return OutputFormat{}, err
+		}
+		return OutputFormat{Name: name, Expr: expr}, nil
+	default:
+		return OutputFormat{}, fmt.Errorf("unknown output format %q; must be one of: table, json, yaml, csv, tsv, name, jsonpath=<expr>, template=<go-template>", name)
+	}
+}
+
+func (f *OutputFormat) Set(value string) error {
+	parsed, err := ParseOutputFormat(value)
+	if err != nil {
+		return err
+	}
+	*f = parsed
+	return nil
+}
+
+func (f *OutputFormat) String() string {
+	if f == nil || f.Name == "" {
+		return ""
+	}
+	if f.Expr != "" {
+		return f.Name + "=" + f.Expr
+	}
+	return f.Name
+}
+
+func (*OutputFormat) Type() string { return "format" }
+
+// WithJSON folds a command's legacy --json flag into the format: --json selects
+// JSON unless -o/--output chose another format.
+func (f OutputFormat) WithJSON(jsonFlag bool) OutputFormat {
+	if jsonFlag && f.Name == "" {
+		return OutputFormat{Name: OutputJSON}
+	}
+	return f
+}
+
+// IsTable reports whether the command should print its human-readable table.
+func (f OutputFormat) IsTable() bool {
+	return f.Name == ""
+}
+
+// IsJSON reports whether the output is JSON, in which case errors are also
+// reported as JSON.
+func (f OutputFormat) IsJSON() bool {
+	return f.Name == OutputJSON
+}
+
+// PrintOutput prints data in the selected format. For the table format it
+// calls printTable, the command's hand-written presenter. Other formats are
+// derived from data's JSON representation, so field names match --json.
+func PrintOutput(format OutputFormat, data any, printTable func()) {
+	if format.IsTable() {
+		printTable()
+		return
+	}
+	if err := RenderOutput(os.Stdout, format, data); err != nil {
+		msg.FailJSON(format.IsJSON(), "Failed to render %s output: %s", format.Name, err)
+		exit.Errorf(err, "Failed to render %s output", format.Name)
+	}
+}
+
+// RenderOutput writes data to w in a non-table format.
+func RenderOutput(w io.Writer, format OutputFormat, data any) error {
+	if format.Name == OutputJSON {
+		_, err := fmt.Fprintln(w, text.IndentJSON(data))
+		return err
+	}
+
+	value, err := toGeneric(data)
+	if err != nil {
+		return err
+	}
+
+	switch format.Name {
+	case OutputYAML:
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(withNativeNumbers(value)); err != nil {
+			return err
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	case OutputCSV:
+		return writeDelimited(w, value, ',')
+	case OutputTSV:
+		return writeDelimited(w, value, '\t')
+	case OutputName:
+		for _, row := range outputRows(value) {
+			if name := rowName(row); name != "" {
+				fmt.Fprintln(w, name)
+			}
+		}
+		return nil
+	case OutputJSONPath:
+		path, err := parseJSONPath(format.Expr)
+		if err != nil {
+			return err
+		}
+		out, err := path.execute(value)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, out)
+		return err
+	case OutputTemplate:
+		tmpl, err := newOutputTemplate(format.Expr)
+		if err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, value); err != nil {
+			return err
+		}
+		if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+		_, err = w.Write(buf.Bytes())
+		return err
+	default:
+		return fmt.Errorf("unsupported output format %q", format.Name)
+	}
+}
+
+// toGeneric round-trips data through JSON so every format sees the same field
+// names as --json.
+func toGeneric(data any) (any, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var value any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// withNativeNumbers converts json.Number values to int64 or float64 so they
+// are encoded as YAML numbers rather than strings.
+func withNativeNumbers(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case []any:
+		out := make([]any, len(v))
+		for i, elem := range v {
+			out[i] = withNativeNumbers(elem)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(v))
+		for k, elem := range v {
+			out[k] = withNativeNumbers(elem)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// outputRows returns the records in value: the elements of a top-level array,
+// the elements of the single array of objects inside a wrapper object such as
+// {"data": [...], "pagination": {...}}, or the value itself.
+func outputRows(value any) []any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	case map[string]any:
+		var found []any
+		count := 0
+		for _, field := range v {
+			if items, ok := field.([]any); ok && len(items) > 0 {
+				if _, isObject := items[0].(map[string]any); isObject {
+					found = items
+					count++
+				}
+			}
+		}
+		if count == 1 {
+			return found
+		}
+		return []any{v}
+	default:
+		return []any{v}
+	}
+}
+
+// rowName returns the identifying name of a record for -o name.
+func rowName(row any) string {
+	obj, ok := row.(map[string]any)
+	if !ok {
+		return scalarString(row)
+	}
+	for _, key := range []string{"name", "id", "backup_id", "restore_job_id"} {
+		if v, ok := obj[key]; ok && v != nil {
+			return scalarString(v)
+		}
+	}
+	return ""
+}
+
+func writeDelimited(w io.Writer, value any, delimiter rune) error {
+	rows := outputRows(value)
+	flat := make([]map[string]string, 0, len(rows))
+	seen := map[string]bool{}
+	var columns []string
+	for _, row := range rows {
+		cells := map[string]string{}
+		flatten("", row, cells)
+		for key := range cells {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+		}
+		flat = append(flat, cells)
+	}
+	sortColumns(columns)
+
+	cw := csv.NewWriter(w)
+	cw.Comma = delimiter
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	for _, cells := range flat {
+		record := make([]string, len(columns))
+		for i, col := range columns {
+			record[i] = cells[col]
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// flatten turns nested objects into dotted column names. Arrays are kept as
+// inline JSON in a single cell.
+func flatten(prefix string, value any, out map[string]string) {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		key := prefix
+		if key == "" {
+			key = "value"
+		}
+		out[key] = scalarString(value)
+		return
+	}
+	for k, v := range obj {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if nested, ok := v.(map[string]any); ok && len(nested) > 0 {
+			flatten(key, nested, out)
+			continue
+		}
+		out[key] = scalarString(v)
+	}
+}
+
+// sortColumns orders columns alphabetically, with identifying columns first.
+func sortColumns(columns []string) {
+	rank := func(c string) int {
+		switch c {
+		case "name":
+			return 0
+		case "id":
+			return 1
+		default:
+			return 2
+		}
+	}
+	sort.SliceStable(columns, func(i, j int) bool {
+		if ri, rj := rank(columns[i]), rank(columns[j]); ri != rj {
+			return ri < rj
+		}
+		return columns[i] < columns[j]
+	})
+}
+
+// scalarString formats a JSON value for a table cell or line of output.
+// Strings are printed without quotes; objects and arrays as inline JSON.
+func scalarString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return text.BoolToString(v)
+	default:
+		return text.InlineJSON(v)
+	}
+}
+
+func newOutputTemplate(expr string) (*template.Template, error) {
+	tmpl, err := template.New("output").Option("missingkey=zero").Funcs(template.FuncMap{
+		"json": func(v any) string { return text.InlineJSON(v) },
+		"join": func(sep string, items []any) string {
+			parts := make([]string, len(items))
+			for i, item := range items {
+				parts[i] = scalarString(item)
+			}
+			return strings.Join(parts, sep)
+		},
+	}).Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
@@ -0,0 +1,142 @@
+package presenters
+
+import (
+	"bytes"
+	"testing"
+)
+
+type testIndex struct {
+	Name      string            `json:"name"`
+	Host      string            `json:"host"`
+	Dimension int               `json:"dimension"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+var testIndexes = []testIndex{
+	{Name: "alpha", Host: "alpha.svc.pinecone.io", Dimension: 3, Tags: map[string]string{"env": "prod"}},
+	{Name: "beta", Host: "beta.svc.pinecone.io", Dimension: 1536},
+}
+
+func render(t *testing.T, format string, data any) string {
+	t.Helper()
+	f, err := ParseOutputFormat(format)
+	if err != nil {
+		t.Fatalf("ParseOutputFormat(%q) returned error: %v", format, err)
+	}
+	var buf bytes.Buffer
+	if err := RenderOutput(&buf, f, data); err != nil {
+		t.Fatalf("RenderOutput(%q) returned error: %v", format, err)
+	}
+	return buf.String()
+}
+
+func TestParseOutputFormat(t *testing.T) {
+	valid := map[string]OutputFormat{
+		"":                   {},
+		"table":              {},
+		"JSON":               {Name: OutputJSON},
+		"yaml":               {Name: OutputYAML},
+		"name":               {Name: OutputName},
+		"jsonpath={.host}":   {Name: OutputJSONPath, Expr: "{.host}"},
+		"template={{.name}}": {Name: OutputTemplate, Expr: "{{.name}}"},
+	}
+	for input, want := range valid {
+		got, err := ParseOutputFormat(input)
+		if err != nil {
+			t.Errorf("ParseOutputFormat(%q) returned error: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseOutputFormat(%q) = %+v, want %+v", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"xml", "jsonpath", "jsonpath=", "yaml=x", "template={{.name", "jsonpath={.name"} {
+		if _, err := ParseOutputFormat(input); err == nil {
+			t.Errorf("ParseOutputFormat(%q) should have failed", input)
+		}
+	}
+}
+
+func TestOutputFormat_WithJSON(t *testing.T) {
+	if got := (OutputFormat{}).WithJSON(true); !got.IsJSON() {
+		t.Errorf("--json should select JSON when no format is given, got %+v", got)
+	}
+	yaml := OutputFormat{Name: OutputYAML}
+	if got := yaml.WithJSON(true); got != yaml {
+		t.Errorf("an explicit format should win over --json, got %+v", got)
+	}
+}
+
+func TestRenderOutput_YAML(t *testing.T) {
+	got := render(t, "yaml", testIndexes[1])
+	want := "dimension: 1536\nhost: beta.svc.pinecone.io\nname: beta\n"
+	if got != want {
+		t.Errorf("unexpected yaml:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderOutput_CSVFlattensNestedFields(t *testing.T) {
+	got := render(t, "csv", testIndexes)
+	want := "name,dimension,host,tags.env\n" +
+		"alpha,3,alpha.svc.pinecone.io,prod\n" +
+		"beta,1536,beta.svc.pinecone.io,\n"
+	if got != want {
+		t.Errorf("unexpected csv:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderOutput_TSVUnwrapsListResponse(t *testing.T) {
+	wrapped := map[string]any{
+		"data":       []map[string]any{{"backup_id": "b1", "status": "Ready"}},
+		"pagination": map[string]any{"next": "token"},
+	}
+	got := render(t, "tsv", wrapped)
+	want := "backup_id\tstatus\nb1\tReady\n"
+	if got != want {
+		t.Errorf("unexpected tsv:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestRenderOutput_Name(t *testing.T) {
+	if got := render(t, "name", testIndexes); got != "alpha\nbeta\n" {
+		t.Errorf("unexpected names: %q", got)
+	}
+}
+
+func TestRenderOutput_JSONPath(t *testing.T) {
+	tests := []struct {
+		expr string
+		data any
+		want string
+	}{
+		{expr: ".host", data: testIndexes[0], want: "alpha.svc.pinecone.io\n"},
+		{expr: "host", data: testIndexes[0], want: "alpha.svc.pinecone.io\n"},
+		{expr: "$.tags.env", data: testIndexes[0], want: "prod\n"},
+		{expr: ".host", data: testIndexes, want: "alpha.svc.pinecone.io\nbeta.svc.pinecone.io\n"},
+		{expr: "[-1].name", data: testIndexes, want: "beta\n"},
+		{expr: "[*].dimension", data: testIndexes, want: "3\n1536\n"},
+		{expr: "['tags']", data: testIndexes[0], want: `{"env":"prod"}` + "\n"},
+		{expr: `{.name}{"\t"}{.dimension}{"\n"}`, data: testIndexes[0], want: "alpha\t3\n"},
+		{expr: `{[*].name}`, data: testIndexes, want: "alpha beta"},
+	}
+	for _, tt := range tests {
+		if got := render(t, "jsonpath="+tt.expr, tt.data); got != tt.want {
+			t.Errorf("jsonpath=%s: got %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestRenderOutput_JSONPathNoMatch(t *testing.T) {
+	f, _ := ParseOutputFormat("jsonpath=.missing")
+	if err := RenderOutput(&bytes.Buffer{}, f, testIndexes[0]); err == nil {
+		t.Error("expected an error when a bare jsonpath matches nothing")
+	}
+}
+
+func TestRenderOutput_Template(t *testing.T) {
+	got := render(t, `template={{range .}}{{.name}}={{.dimension}} {{end}}`, testIndexes)
+	if got != "alpha=3 beta=1536 \n" {
+		t.Errorf("unexpected template output: %q", got)
+	}
+}
@@ -2,20 +2,28 @@ package presenters
 
 import (
 	"bytes"
+	"cmp"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"unicode"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 )
 
 func NewTabWriter() *tabwriter.Writer {
 	return tabwriter.NewWriter(os.Stdout, 12, 1, 3, ' ', 0)
 }
 
-// tableColumn describes a single column in a printColorizedTable call.
-type tableColumn struct {
-	header    string
-	colorizer func(string) string // nil = no colorization
+// TableColumn describes a single column of a Table.
+type TableColumn struct {
+	Header    string
+	Colorizer func(string) string // nil = no colorization
 }
 
 // printColorizedTable renders a tab-aligned table to stdout. Rows contain plain
@@ -23,10 +31,10 @@ type tableColumn struct {
 // computed column widths, so ANSI bytes never affect alignment.
 // Columns are processed right-to-left so an earlier substitution never shifts
 // the byte offsets of columns already replaced in the same line.
-func printColorizedTable(cols []tableColumn, rows [][]string) {
+func printColorizedTable(cols []TableColumn, rows [][]string) {
 	headers := make([]string, len(cols))
 	for i, c := range cols {
-		headers[i] = c.header
+		headers[i] = c.Header
 	}
 
 	var buf bytes.Buffer
@@ -55,11 +63,11 @@ func printColorizedTable(cols []tableColumn, rows [][]string) {
 	for i, row := range rows {
 		line := lines[i+1]
 		for j := len(cols) - 1; j >= 0; j-- {
-			if cols[j].colorizer == nil {
+			if cols[j].Colorizer == nil {
 				continue
 			}
 			plain := row[j]
-			colored := cols[j].colorizer(plain)
+			colored := cols[j].Colorizer(plain)
 			if colored == plain {
 				continue
 			}
@@ -83,3 +91,366 @@ func PrintEmptyState(writer *tabwriter.Writer, resource string) bool {
 	writer.Flush()
 	return true
 }
+
+// Help text for the flags that narrow a list command's output.
+const (
+	ColumnsFlagUsage = "Comma-separated columns to show, by table header or JSON field (e.g. name,status,tags.env)"
+	SortByFlagUsage  = "Column or JSON field to sort by; prefix with - for descending order (e.g. -created_at)"
+	WhereFlagUsage   = "Only show items where a column or JSON field matches, e.g. 'status=Ready,tags.env=prod'; use != to exclude"
+)
+
+// ListOptions holds the --columns, --sort-by and --where flags of list commands.
+type ListOptions struct {
+	Columns []string
+	SortBy  []string
+	Where   []string
+}
+
+// Table is the tabular form of a list command's result. Records holds the API
+// object behind each row, so --columns, --sort-by and --where can refer to
+// JSON fields that are not displayed as well as to the table headers.
+type Table struct {
+	// Resource names the listed items in the empty state, e.g. "backups".
+	Resource string
+	// ListField is the JSON field holding the records when a list command
+	// prints a response that wraps them, e.g. "data" of a backup list with
+	// its pagination. It is empty when the command prints a bare array.
+	ListField string
+	Columns   []TableColumn
+	Rows      [][]string
+	Records   []any
+
+	fields []map[string]string
+}
+
+// PrintTable prints t to stdout, or an empty state when it has no rows.
+func PrintTable(t Table) {
+	if len(t.Rows) == 0 {
+		PrintEmptyState(NewTabWriter(), t.Resource)
+		return
+	}
+	printColorizedTable(t.Columns, t.Rows)
+}
+
+// PrintList prints a list command's result after applying the --columns,
+// --sort-by and --where options. For the table format it calls printTable
+// with the narrowed table; other formats render data, with its list replaced
+// by only the matching records when --where or --sort-by were given.
+func PrintList(format OutputFormat, opts ListOptions, data any, table Table, printTable func(Table)) {
+	applied, err := table.Apply(opts)
+	if err != nil {
+		err = clierr.New(clierr.CodeUsage, "%s", err)
+		msg.FailJSON(format.IsJSON(), "%s", err)
+		exit.Error(err, "Invalid list options")
+	}
+	if format.IsTable() {
+		printTable(applied)
+		return
+	}
+	if len(opts.Where) > 0 || len(opts.SortBy) > 0 {
+		data, err = applied.withRecords(data)
+		if err != nil {
+			msg.FailJSON(format.IsJSON(), "Failed to render %s output: %s", format.Name, err)
+			exit.Errorf(err, "Failed to render %s output", format.Name)
+		}
+	}
+	PrintOutput(format, data, nil)
+}
+
+// withRecords returns data with its list replaced by t.Records, keeping the
+// rest of a wrapping response such as its pagination. No matches give an
+// empty list rather than null.
+func (t Table) withRecords(data any) (any, error) {
+	records := t.Records
+	if records == nil {
+		records = []any{}
+	}
+	if t.ListField == "" {
+		return records, nil
+	}
+	value, err := toGeneric(data)
+	if err != nil {
+		return nil, err
+	}
+	wrapper, ok := value.(map[string]any)
+	if !ok {
+		wrapper = map[string]any{}
+	}
+	wrapper[t.ListField] = records
+	return wrapper, nil
+}
+
+// Apply returns a copy of t with the rows matching opts.Where, ordered by
+// opts.SortBy and limited to opts.Columns.
+func (t Table) Apply(opts ListOptions) (Table, error) {
+	filters, err := parseWhere(opts.Where)
+	if err != nil {
+		return Table{}, err
+	}
+	for _, f := range filters {
+		if !t.hasKey(f.key) {
+			return Table{}, fmt.Errorf("unknown --where field %q; available columns: %s", f.key, t.headerList())
+		}
+	}
+
+	out := Table{Resource: t.Resource, ListField: t.ListField, Columns: t.Columns}
+	for i := range t.Rows {
+		if t.matches(i, filters) {
+			out.Rows = append(out.Rows, t.Rows[i])
+			out.Records = append(out.Records, t.record(i))
+			out.fields = append(out.fields, t.fieldsOf(i))
+		}
+	}
+	if len(t.Rows) > 0 && len(out.Rows) == 0 {
+		out.Resource = "matching " + t.Resource
+	}
+
+	if err := out.sort(opts.SortBy); err != nil {
+		return Table{}, err
+	}
+	if len(opts.Columns) > 0 {
+		if err := out.selectColumns(opts.Columns); err != nil {
+			return Table{}, err
+		}
+	}
+	return out, nil
+}
+
+type whereFilter struct {
+	key    string
+	value  string
+	negate bool
+}
+
+func parseWhere(exprs []string) ([]whereFilter, error) {
+	var filters []whereFilter
+	for _, expr := range exprs {
+		expr = strings.TrimSpace(expr)
+		if expr == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(expr, "=")
+		if !ok || strings.TrimSpace(key) == "" || strings.TrimSpace(key) == "!" {
+			return nil, fmt.Errorf("invalid --where condition %q: expected key=value or key!=value", expr)
+		}
+		f := whereFilter{value: strings.TrimSpace(value)}
+		if strings.HasSuffix(key, "!") {
+			f.negate = true
+			key = strings.TrimSuffix(key, "!")
+		}
+		f.key = strings.TrimSpace(key)
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+func (t *Table) matches(row int, filters []whereFilter) bool {
+	for _, f := range filters {
+		value, _ := t.lookup(row, f.key)
+		if strings.EqualFold(value, f.value) == f.negate {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *Table) sort(keys []string) error {
+	type sortKey struct {
+		key  string
+		desc bool
+	}
+	var sortKeys []sortKey
+	for _, k := range keys {
+		k = strings.TrimSpace(k)
+		desc := strings.HasPrefix(k, "-")
+		k = strings.TrimPrefix(k, "-")
+		if k == "" {
+			continue
+		}
+		if !t.hasKey(k) {
+			return fmt.Errorf("unknown --sort-by field %q; available columns: %s", k, t.headerList())
+		}
+		sortKeys = append(sortKeys, sortKey{key: k, desc: desc})
+	}
+	if len(sortKeys) == 0 {
+		return nil
+	}
+
+	order := make([]int, len(t.Rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		for _, k := range sortKeys {
+			va, _ := t.lookup(order[a], k.key)
+			vb, _ := t.lookup(order[b], k.key)
+			if c := compareValues(va, vb, k.desc); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+
+	rows := make([][]string, len(order))
+	records := make([]any, len(order))
+	fields := make([]map[string]string, len(order))
+	for i, idx := range order {
+		rows[i], records[i], fields[i] = t.Rows[idx], t.record(idx), t.fieldsOf(idx)
+	}
+	t.Rows, t.Records, t.fields = rows, records, fields
+	return nil
+}
+
+func (t *Table) selectColumns(keys []string) error {
+	var cols []TableColumn
+	rows := make([][]string, len(t.Rows))
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		if col := t.columnIndex(key); col >= 0 {
+			cols = append(cols, t.Columns[col])
+			for i := range t.Rows {
+				rows[i] = append(rows[i], t.Rows[i][col])
+			}
+			continue
+		}
+		if !t.hasKey(key) {
+			return fmt.Errorf("unknown column %q; available columns: %s", key, t.headerList())
+		}
+		cols = append(cols, TableColumn{Header: strings.ToUpper(key)})
+		for i := range t.Rows {
+			value, ok := t.lookup(i, key)
+			if !ok || value == "" {
+				value = nonePlaceholder
+			}
+			rows[i] = append(rows[i], value)
+		}
+	}
+	t.Columns, t.Rows = cols, rows
+	return nil
+}
+
+// lookup returns the value of key in a row: the cell of the column whose
+// header matches key, otherwise the record's JSON field with that dotted path.
+func (t *Table) lookup(row int, key string) (string, bool) {
+	if col := t.columnIndex(key); col >= 0 {
+		return t.Rows[row][col], true
+	}
+	value, ok := t.fieldsOf(row)[normalizeFieldKey(key)]
+	return value, ok
+}
+
+// hasKey reports whether key names a column or a field of any record.
+func (t *Table) hasKey(key string) bool {
+	if t.columnIndex(key) >= 0 {
+		return true
+	}
+	for i := range t.Rows {
+		if _, ok := t.lookup(i, key); ok {
+			return true
+		}
+	}
+	return len(t.Rows) == 0
+}
+
+func (t *Table) columnIndex(key string) int {
+	key = normalizeFieldKey(key)
+	for i, c := range t.Columns {
+		if normalizeFieldKey(c.Header) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *Table) headerList() string {
+	names := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = normalizeFieldKey(c.Header)
+	}
+	return strings.Join(names, ", ") + " (or any JSON field)"
+}
+
+func (t *Table) record(row int) any {
+	if row < len(t.Records) {
+		return t.Records[row]
+	}
+	return nil
+}
+
+// fieldsOf returns the flattened JSON fields of a row's record, keyed by
+// normalized dotted path.
+func (t *Table) fieldsOf(row int) map[string]string {
+	if len(t.fields) != len(t.Rows) {
+		t.fields = make([]map[string]string, len(t.Rows))
+	}
+	if t.fields[row] == nil {
+		fields := map[string]string{}
+		if value, err := toGeneric(t.record(row)); err == nil && value != nil {
+			flat := map[string]string{}
+			flatten("", value, flat)
+			for k, v := range flat {
+				fields[normalizeFieldKey(k)] = v
+			}
+		}
+		t.fields[row] = fields
+	}
+	return t.fields[row]
+}
+
+// normalizeFieldKey lets "CREATED AT", "created-at" and "created_at" name the
+// same column.
+func normalizeFieldKey(key string) string {
+	var b strings.Builder
+	pendingSep := false
+	for _, r := range strings.ToLower(strings.TrimSpace(key)) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '.' {
+			if pendingSep && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+			pendingSep = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingSep = true
+	}
+	return b.String()
+}
+
+// compareValues orders two cells numerically when both are numbers (an
+// optional % suffix is ignored) and case-insensitively otherwise. Empty values
+// always sort last.
+func compareValues(a, b string, desc bool) int {
+	emptyA, emptyB := isEmptyCell(a), isEmptyCell(b)
+	switch {
+	case emptyA && emptyB:
+		return 0
+	case emptyA:
+		return 1
+	case emptyB:
+		return -1
+	}
+
+	var c int
+	fa, errA := strconv.ParseFloat(strings.TrimSuffix(a, "%"), 64)
+	fb, errB := strconv.ParseFloat(strings.TrimSuffix(b, "%"), 64)
+	if errA == nil && errB == nil {
+		c = cmp.Compare(fa, fb)
+	} else {
+		c = strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+	if desc {
+		return -c
+	}
+	return c
+}
+
+func isEmptyCell(value string) bool {
+	switch value {
+	case "", "-", "<none>", "nil":
+		return true
+	}
+	return false
+}
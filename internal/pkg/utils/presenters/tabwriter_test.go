@@ -2,6 +2,8 @@ package presenters
 
 import (
 	"bytes"
+	"encoding/json"
+	"strconv"
 	"strings"
 	"testing"
 	"text/tabwriter"
@@ -20,3 +22,162 @@ func TestPrintEmptyState(t *testing.T) {
 		t.Fatalf("unexpected output: %q", got)
 	}
 }
+
+type testBackup struct {
+	BackupID  string            `json:"backup_id"`
+	Status    string            `json:"status"`
+	Records   int               `json:"record_count"`
+	CreatedAt string            `json:"created_at"`
+	Tags      map[string]string `json:"tags,omitempty"`
+}
+
+func testBackupTable() Table {
+	backups := []testBackup{
+		{BackupID: "b1", Status: "Ready", Records: 20, CreatedAt: "2025-01-02T00:00:00Z", Tags: map[string]string{"env": "prod"}},
+		{BackupID: "b2", Status: "Initializing", Records: 100, CreatedAt: "2025-03-01T00:00:00Z"},
+		{BackupID: "b3", Status: "Ready", Records: 3, CreatedAt: "2025-02-01T00:00:00Z", Tags: map[string]string{"env": "dev"}},
+	}
+	table := Table{
+		Resource: "backups",
+		Columns:  []TableColumn{{Header: "BACKUP ID"}, {Header: "STATUS"}, {Header: "RECORDS"}},
+	}
+	for _, b := range backups {
+		table.Rows = append(table.Rows, []string{b.BackupID, b.Status, strconv.Itoa(b.Records)})
+		table.Records = append(table.Records, b)
+	}
+	return table
+}
+
+func firstColumn(t Table) string {
+	ids := make([]string, len(t.Rows))
+	for i, row := range t.Rows {
+		ids[i] = row[0]
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestTableApply_Where(t *testing.T) {
+	tests := []struct {
+		where []string
+		want  string
+	}{
+		{where: []string{"status=ready"}, want: "b1,b3"},
+		{where: []string{"status=Ready", "tags.env=prod"}, want: "b1"},
+		{where: []string{"status!=Ready"}, want: "b2"},
+		{where: []string{"backup-id=b2"}, want: "b2"},
+	}
+	for _, tt := range tests {
+		got, err := testBackupTable().Apply(ListOptions{Where: tt.where})
+		if err != nil {
+			t.Fatalf("Apply(%v) returned error: %v", tt.where, err)
+		}
+		if ids := firstColumn(got); ids != tt.want {
+			t.Errorf("Apply(%v) = %s, want %s", tt.where, ids, tt.want)
+		}
+		if len(got.Records) != len(got.Rows) {
+			t.Errorf("Apply(%v) kept %d records for %d rows", tt.where, len(got.Records), len(got.Rows))
+		}
+	}
+}
+
+func TestTableApply_SortBy(t *testing.T) {
+	tests := []struct {
+		sortBy string
+		want   string
+	}{
+		{sortBy: "records", want: "b3,b1,b2"},
+		{sortBy: "-records", want: "b2,b1,b3"},
+		{sortBy: "-created_at", want: "b2,b3,b1"},
+	}
+	for _, tt := range tests {
+		got, err := testBackupTable().Apply(ListOptions{SortBy: []string{tt.sortBy}})
+		if err != nil {
+			t.Fatalf("Apply(--sort-by %s) returned error: %v", tt.sortBy, err)
+		}
+		if ids := firstColumn(got); ids != tt.want {
+			t.Errorf("--sort-by %s = %s, want %s", tt.sortBy, ids, tt.want)
+		}
+	}
+}
+
+func TestTableApply_Columns(t *testing.T) {
+	got, err := testBackupTable().Apply(ListOptions{Columns: []string{"status", "backup_id", "tags.env"}})
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	var headers []string
+	for _, c := range got.Columns {
+		headers = append(headers, c.Header)
+	}
+	if h := strings.Join(headers, ","); h != "STATUS,BACKUP ID,TAGS.ENV" {
+		t.Errorf("unexpected headers %s", h)
+	}
+	if row := strings.Join(got.Rows[1], ","); row != "Initializing,b2,<none>" {
+		t.Errorf("unexpected row %s", row)
+	}
+}
+
+func TestTableApply_UnknownField(t *testing.T) {
+	for _, opts := range []ListOptions{
+		{Columns: []string{"nope"}},
+		{SortBy: []string{"-nope"}},
+		{Where: []string{"nope=1"}},
+		{Where: []string{"status"}},
+	} {
+		if _, err := testBackupTable().Apply(opts); err == nil {
+			t.Errorf("Apply(%+v) should have failed", opts)
+		}
+	}
+}
+
+func TestTableWithRecords_KeepsWrapper(t *testing.T) {
+	type page struct {
+		Next string `json:"next"`
+	}
+	type backupList struct {
+		Data       []testBackup `json:"data"`
+		Pagination *page        `json:"pagination,omitempty"`
+	}
+	table := testBackupTable()
+	table.ListField = "data"
+	list := backupList{Pagination: &page{Next: "token"}}
+	for _, r := range table.Records {
+		list.Data = append(list.Data, r.(testBackup))
+	}
+
+	tests := []struct {
+		where []string
+		want  string
+	}{
+		{where: []string{"status=Initializing"}, want: `{"data":[{"backup_id":"b2","status":"Initializing","record_count":100,"created_at":"2025-03-01T00:00:00Z"}],"pagination":{"next":"token"}}`},
+		{where: []string{"status=Deleting"}, want: `{"data":[],"pagination":{"next":"token"}}`},
+	}
+	for _, tt := range tests {
+		applied, err := table.Apply(ListOptions{Where: tt.where})
+		if err != nil {
+			t.Fatalf("Apply(%v) returned error: %v", tt.where, err)
+		}
+		data, err := applied.withRecords(list)
+		if err != nil {
+			t.Fatalf("withRecords returned error: %v", err)
+		}
+		got, _ := json.Marshal(data)
+		if string(got) != tt.want {
+			t.Errorf("--where %v printed %s, want %s", tt.where, got, tt.want)
+		}
+	}
+}
+
+func TestTableWithRecords_BareList(t *testing.T) {
+	applied, err := testBackupTable().Apply(ListOptions{Where: []string{"status=Deleting"}})
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	data, err := applied.withRecords(nil)
+	if err != nil {
+		t.Fatalf("withRecords returned error: %v", err)
+	}
+	if got, _ := json.Marshal(data); string(got) != "[]" {
+		t.Errorf("no matches printed %s, want []", got)
+	}
+}
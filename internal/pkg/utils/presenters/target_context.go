@@ -2,8 +2,10 @@ package presenters
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
 	"github.com/pinecone-io/cli/internal/pkg/utils/log"
@@ -17,6 +19,15 @@ func labelUnsetIfEmpty(value string) string {
 	return value
 }
 
+// labelUnknownIfPinned shows a placeholder for a name that cannot be resolved
+// locally because only the ID was pinned (e.g. by a project file).
+func labelUnknownIfPinned(name, id string) string {
+	if name == "" && id != "" {
+		return "<unknown>"
+	}
+	return labelUnsetIfEmpty(name)
+}
+
 func PrintTargetContext(context *state.TargetContext) {
 	writer := NewTabWriter()
 	if context == nil {
@@ -29,18 +40,38 @@ func PrintTargetContext(context *state.TargetContext) {
 		Str("project", context.Project.Name).
 		Msg("Printing target context")
 
-	columns := []string{"ATTRIBUTE", "VALUE"}
+	columns := []string{"ATTRIBUTE", "VALUE", "SOURCE"}
 	header := strings.Join(columns, "\t") + "\n"
 	fmt.Fprint(writer, header)
 
 	// Get API key for presentational layer
-	defaultAPIKeyMasked := MaskHeadTail(secrets.DefaultAPIKey.Get(), 4, 4)
+	defaultAPIKey := secrets.GetDefaultAPIKey()
+	defaultAPIKeyMasked := MaskHeadTail(defaultAPIKey, 4, 4)
+	apiKeySource := configuration.SourceNone
+	if secrets.UsingCredentialHelper() {
+		apiKeySource = configuration.SourceCredentialHelper
+	} else if os.Getenv("PINECONE_API_KEY") != "" {
+		apiKeySource = configuration.SourceEnv
+	} else if defaultAPIKey != "" {
+		apiKeySource = configuration.SourceGlobal
+	}
 
-	fmt.Fprintf(writer, "Organization\t%s\n", labelUnsetIfEmpty(context.Organization.Name))
-	fmt.Fprintf(writer, "Organization ID\t%s\n", labelUnsetIfEmpty(context.Organization.Id))
-	fmt.Fprintf(writer, "Project\t%s\n", labelUnsetIfEmpty(context.Project.Name))
-	fmt.Fprintf(writer, "Project ID\t%s\n", labelUnsetIfEmpty(context.Project.Id))
-	fmt.Fprintf(writer, "Default API Key\t%s\n", labelUnsetIfEmpty(defaultAPIKeyMasked))
+	orgSource := string(context.Sources.Organization)
+	projSource := string(context.Sources.Project)
+	fmt.Fprintf(writer, "Organization\t%s\t%s\n", labelUnknownIfPinned(context.Organization.Name, context.Organization.Id), orgSource)
+	fmt.Fprintf(writer, "Organization ID\t%s\t%s\n", labelUnsetIfEmpty(context.Organization.Id), orgSource)
+	fmt.Fprintf(writer, "Project\t%s\t%s\n", labelUnknownIfPinned(context.Project.Name, context.Project.Id), projSource)
+	fmt.Fprintf(writer, "Project ID\t%s\t%s\n", labelUnsetIfEmpty(context.Project.Id), projSource)
+	fmt.Fprintf(writer, "Default API Key\t%s\t%s\n", labelUnsetIfEmpty(defaultAPIKeyMasked), apiKeySource)
+	if context.IndexName != "" {
+		fmt.Fprintf(writer, "Default Index\t%s\t%s\n", context.IndexName, context.Sources.IndexName)
+	}
+	if context.Namespace != "" {
+		fmt.Fprintf(writer, "Default Namespace\t%s\t%s\n", context.Namespace, context.Sources.Namespace)
+	}
+	if context.ProjectFile != "" {
+		fmt.Fprintf(writer, "Project File\t%s\t\n", context.ProjectFile)
+	}
 
 	writer.Flush()
 }
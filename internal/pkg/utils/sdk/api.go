@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/environment"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/cli/internal/pkg/utils/oauth"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// APIVersion is the X-Pinecone-Api-Version the SDK sends; pc api uses it
+// unless --api-version is given.
+const APIVersion = "2025-10"
+
+// RestClient returns the HTTP client the SDK uses, for commands that call the
+// API directly.
+func RestClient() *http.Client {
+	return newRestClient()
+}
+
+// APIAuthHeaders returns the headers that authenticate a raw API request, using
+// the same credential priority as NewPineconeClient. The admin API only
+// accepts bearer tokens. For the control and data planes, an API key is sent
+// as Api-Key; otherwise a user or service account token is sent with the
+// target project in X-Project-Id.
+func APIAuthHeaders(ctx context.Context, admin bool) (http.Header, error) {
+	h := http.Header{}
+	if local.Enabled() {
+		if admin {
+			return nil, clierr.New(clierr.CodeUsage, "the admin API is not available with Pinecone Local")
+		}
+		h.Set("Api-Key", local.APIKey)
+		return h, nil
+	}
+
+	token, err := oauth.Token(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("Error retrieving oauth token")
+	}
+	clientId := secrets.GetClientId()
+	clientSecret := secrets.GetClientSecret()
+	apiKey := secrets.GetDefaultAPIKey()
+	if admin {
+		// An API key can't call the admin API, so skip it
+		apiKey = ""
+	}
+
+	bearer := func(accessToken string) (http.Header, error) {
+		h.Set("Authorization", "Bearer "+accessToken)
+		if !admin {
+			target, _ := state.ResolveTargetProject()
+			projectId := target.Id
+			if projectId == "" {
+				return nil, clierr.New(clierr.CodeUsage, "no target project set; run pc target")
+			}
+			h.Set("X-Project-Id", projectId)
+		}
+		return h, nil
+	}
+
+	switch ResolveCredentialSource(apiKey, token, clientId, clientSecret) {
+	case CredentialsAPIKey:
+		h.Set("Api-Key", apiKey)
+		return h, nil
+	case CredentialsUserToken:
+		return bearer(token.AccessToken)
+	case CredentialsServiceAccount:
+		accessToken, err := ServiceAccountToken(ctx, clientId, clientSecret)
+		if err != nil {
+			return nil, err
+		}
+		return bearer(accessToken)
+	default:
+		if admin {
+			return nil, clierr.New(clierr.CodeUnauthenticated, "the admin API needs a user login or service account credentials")
+		}
+		return nil, clierr.New(clierr.CodeUnauthenticated, "not logged in")
+	}
+}
+
+// ServiceAccountToken exchanges service account credentials for an access
+// token, as the SDK's admin client does.
+func ServiceAccountToken(ctx context.Context, clientId, clientSecret string) (string, error) {
+	envConfig, err := environment.GetEnvConfig(config.GetEnvironment())
+	if err != nil {
+		return "", err
+	}
+	if envConfig.Auth0URL == "" {
+		return "", fmt.Errorf("environment %q does not support service accounts", config.GetEnvironment())
+	}
+	cc := clientcredentials.Config{
+		ClientID:       clientId,
+		ClientSecret:   clientSecret,
+		TokenURL:       envConfig.Auth0URL + "/oauth/token",
+		EndpointParams: url.Values{"audience": {strings.TrimRight(getPineconeHostURL(), "/") + "/"}},
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, transport.HTTPClient())
+	token, err := cc.Token(ctx)
+	if err != nil {
+		return "", clierr.Wrap(clierr.CodeUnauthenticated, fmt.Errorf("failed to get service account token: %w", err))
+	}
+	return token.AccessToken, nil
+}
+
+// IndexHostURL returns the base URL of an index's data plane, with the same
+// host overrides as NewIndexConnection.
+func IndexHostURL(index *pinecone.Index) string {
+	host := indexHost(index)
+	if strings.Contains(host, "://") {
+		return host
+	}
+	return "https://" + host
+}
@@ -3,19 +3,28 @@ package sdk
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
 	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
 	"github.com/pinecone-io/cli/internal/pkg/utils/environment"
 	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
 	"github.com/pinecone-io/cli/internal/pkg/utils/log"
 	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
 	"github.com/pinecone-io/cli/internal/pkg/utils/oauth"
 	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/pinecone-io/cli/internal/pkg/utils/tracing"
+	"github.com/pinecone-io/cli/internal/pkg/utils/transport"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
 
 	"github.com/pinecone-io/go-pinecone/v5/pinecone"
 )
@@ -26,37 +35,45 @@ const (
 )
 
 func NewPineconeClient(ctx context.Context) *pinecone.Client {
-	targetOrgId := state.TargetOrg.Get().Id
-	targetProjectId := state.TargetProj.Get().Id
+	if local.Enabled() {
+		log.Debug().Str("host", local.Host()).Msg("Creating client for Pinecone Local")
+		return NewClientForLocal()
+	}
+
+	targetOrg, orgSource := state.ResolveTargetOrg()
+	targetProject, projectSource := state.ResolveTargetProject()
+	targetProjectId := targetProject.Id
 	log.Debug().
-		Str("targetOrgId", targetOrgId).
+		Str("targetOrgId", targetOrg.Id).
+		Str("targetOrgSource", string(orgSource)).
 		Str("targetProjectId", targetProjectId).
+		Str("targetProjectSource", string(projectSource)).
 		Msg("Loading target context")
 
 	oauth2Token, err := oauth.Token(ctx)
 	if err != nil {
 		log.Error().Err(err).Msg("Error retrieving oauth token")
 	}
-	clientId := secrets.ClientId.Get()
-	clientSecret := secrets.ClientSecret.Get()
-	defaultAPIKey := secrets.DefaultAPIKey.Get()
+	clientId := secrets.GetClientId()
+	clientSecret := secrets.GetClientSecret()
+	defaultAPIKey := secrets.GetDefaultAPIKey()
 
+	switch ResolveCredentialSource(defaultAPIKey, oauth2Token, clientId, clientSecret) {
 	// If there's a default API key set, it takes priority over user/service account tokens and associated keys
-	if secrets.DefaultAPIKey.Get() != "" {
+	case CredentialsAPIKey:
 		if oauth2Token != nil && oauth2Token.AccessToken != "" {
 			msg.WarnMsg("You are currently logged in and also have an API key set in your environment and/or local configuration. The API key (which is linked to a specific project) will be used in preference to any user authentication and target context that may be present.\n")
 		}
 
 		log.Debug().Msg("Creating client for machine using stored API key")
-		return NewClientForAPIKey(secrets.DefaultAPIKey.Get())
-	}
-	log.Debug().Msg("No default API key is stored in configuration, attempting to create a client using user access token")
+		return NewClientForAPIKey(defaultAPIKey)
 
 	// If neither user token or service account credentials are set, we cannot instantiate a client
-	if oauth2Token == nil || oauth2Token.AccessToken == "" && (clientId == "" && clientSecret == "") && defaultAPIKey == "" {
+	case CredentialsNone:
 		msg.FailMsg("Please configure user credentials before attempting this operation. Log in with %s, configure a service account with %s, or set an explicit API key with %s.", style.Code("pc auth login"), style.Code("pc auth configure --client-id --client-secret"), style.Code("pc config set-api-key"))
 		exit.ErrorMsg("User credentials are not configured")
 	}
+	log.Debug().Msg("No default API key is stored in configuration, attempting to create a client using user access token")
 
 	// Lastly, if the user is not targeting a project, we cannot instantiate the client for
 	// user or service account scenarios
@@ -68,20 +85,91 @@ func NewPineconeClient(ctx context.Context) *pinecone.Client {
 	return NewPineconeClientForProjectById(ctx, targetProjectId)
 }
 
+// CredentialSource is the credential NewPineconeClient authenticates with.
+type CredentialSource string
+
+const (
+	CredentialsNone           CredentialSource = "none"
+	CredentialsAPIKey         CredentialSource = "api_key"
+	CredentialsUserToken      CredentialSource = "user_token"
+	CredentialsServiceAccount CredentialSource = "service_account"
+)
+
+// ResolveCredentialSource returns which of the available credentials wins: the
+// default API key, then the user's OAuth token, then the service account.
+func ResolveCredentialSource(defaultAPIKey string, token *oauth2.Token, clientId, clientSecret string) CredentialSource {
+	switch {
+	case defaultAPIKey != "":
+		return CredentialsAPIKey
+	case token != nil && token.AccessToken != "":
+		return CredentialsUserToken
+	case clientId != "" && clientSecret != "":
+		return CredentialsServiceAccount
+	default:
+		return CredentialsNone
+	}
+}
+
 func NewPineconeClientForProjectById(ctx context.Context, projectId string) *pinecone.Client {
 	ac := NewPineconeAdminClient(ctx)
 
-	project, err := ac.Project.Describe(ctx, projectId)
+	pc, err := newClientForProject(ctx, ac, projectId)
+	if err != nil {
+		msg.FailMsg("%s", err)
+		exit.Error(err, "Failed to create Pinecone client")
+	}
+
+	return pc
+}
+
+// TryNewPineconeClient is NewPineconeClient for callers that can't print or
+// exit on failure, such as shell completion.
+func TryNewPineconeClient(ctx context.Context) (*pinecone.Client, error) {
+	if local.Enabled() {
+		return NewClientForLocal(), nil
+	}
+
+	oauth2Token, err := oauth.Token(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("Error retrieving oauth token")
+	}
+	defaultAPIKey := secrets.GetDefaultAPIKey()
+
+	switch ResolveCredentialSource(defaultAPIKey, oauth2Token, secrets.GetClientId(), secrets.GetClientSecret()) {
+	case CredentialsAPIKey:
+		return pinecone.NewClient(pinecone.NewClientParams{
+			ApiKey:     defaultAPIKey,
+			SourceTag:  cliSourceTag(),
+			Host:       getPineconeHostURL(),
+			RestClient: newRestClient(),
+		})
+	case CredentialsNone:
+		return nil, clierr.New(clierr.CodeUnauthenticated, "not logged in")
+	}
+
+	targetProject, _ := state.ResolveTargetProject()
+	if targetProject.Id == "" {
+		return nil, clierr.New(clierr.CodeUsage, "no target project set")
+	}
+	ac, err := TryNewPineconeAdminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return newClientForProject(ctx, ac, targetProject.Id)
+}
+
+// newClientForProject returns a client for projectId authenticated with the
+// project's CLI managed API key, creating the key if needed.
+func newClientForProject(ctx context.Context, ac *pinecone.AdminClient, projectId string) (*pinecone.Client, error) {
+	project, err := describeProject(ctx, ac, projectId)
 	if err != nil {
-		msg.FailMsg("Failed to get project %s: %s", style.Emphasis(projectId), err)
-		exit.Errorf(err, "Failed to get project %s", style.Emphasis(projectId))
+		return nil, fmt.Errorf("failed to get project %s: %w", style.Emphasis(projectId), err)
 	}
 
 	// Get the stored ManagedKey for the project, a new key is created if one doesn't exist
 	key, err := getCLIAPIKeyForProject(ctx, ac, project)
 	if err != nil {
-		msg.FailMsg("Failed to retrieve or create an API key for the project %s (ID: %s)", project.Name, project.Id)
-		exit.Errorf(err, "Failed to retrieve or create API key for project %s (ID: %s)", project.Name, project.Id)
+		return nil, fmt.Errorf("failed to retrieve or create an API key for the project %s (ID: %s): %w", project.Name, project.Id, err)
 	}
 
 	// Header is required for allowing user token to work across data/control plane APIs
@@ -89,29 +177,30 @@ func NewPineconeClientForProjectById(ctx context.Context, projectId string) *pin
 	headers["X-Project-Id"] = projectId
 
 	pc, err := pinecone.NewClient(pinecone.NewClientParams{
-		ApiKey:    key,
-		SourceTag: cliSourceTag(),
-		Host:      getPineconeHostURL(),
-		Headers:   headers,
+		ApiKey:     key,
+		SourceTag:  cliSourceTag(),
+		Host:       getPineconeHostURL(),
+		Headers:    headers,
+		RestClient: newRestClient(),
 	})
 	if err != nil {
-		msg.FailMsg("Failed to create Pinecone client: %s", err)
-		exit.Error(err, "failed to create Pinecone Client")
+		return nil, fmt.Errorf("failed to create Pinecone client: %w", err)
 	}
 
-	return pc
+	return pc, nil
 }
 
 func NewClientForAPIKey(apiKey string) *pinecone.Client {
 	if apiKey == "" {
 		msg.FailMsg("API key not set. Please run %s", style.Code("pc auth configure --api-key"))
-		exit.ErrorMsgf("API key not set. Please run %s", style.Code("pc config set-api-key"))
+		exit.Error(clierr.New(clierr.CodeUnauthenticated, "API key not set"), "API key not set")
 	}
 
 	pc, err := pinecone.NewClient(pinecone.NewClientParams{
-		ApiKey:    apiKey,
-		SourceTag: cliSourceTag(),
-		Host:      getPineconeHostURL(),
+		ApiKey:     apiKey,
+		SourceTag:  cliSourceTag(),
+		Host:       getPineconeHostURL(),
+		RestClient: newRestClient(),
 	})
 	if err != nil {
 		exit.Error(err, "Failed to create Pinecone client")
@@ -120,53 +209,109 @@ func NewClientForAPIKey(apiKey string) *pinecone.Client {
 	return pc
 }
 
+// NewClientForLocal returns a client for the Pinecone Local emulator, which
+// needs no credentials and serves plaintext HTTP.
+func NewClientForLocal() *pinecone.Client {
+	pc, err := pinecone.NewClient(pinecone.NewClientParams{
+		ApiKey:     local.APIKey,
+		SourceTag:  cliSourceTag(),
+		Host:       local.Host(),
+		RestClient: newRestClient(),
+	})
+	if err != nil {
+		exit.Error(err, "Failed to create Pinecone Local client")
+	}
+
+	return pc
+}
+
 func NewPineconeAdminClient(ctx context.Context) *pinecone.AdminClient {
+	ac, err := TryNewPineconeAdminClient(ctx)
+	if err != nil {
+		if local.Enabled() {
+			msg.FailMsg("Organizations, projects and API keys are not available with Pinecone Local. Unset %s and drop %s to use Pinecone.", local.EnvVar, style.Code("--local"))
+			exit.Error(err, "Admin API not available with Pinecone Local")
+		}
+		var ce *clierr.Error
+		if errors.As(err, &ce) && ce.Code == clierr.CodeUnauthenticated {
+			msg.FailMsg("Please login with %s or configure credentials with %s before attempting this operation.", style.Code("pc auth login"), style.Code("pc auth configure"))
+			exit.Error(err, "User is not logged in")
+		}
+		msg.FailMsg("Failed to create Pinecone admin client: %s", err)
+		exit.Error(err, "Failed to create Pinecone admin client")
+	}
+	return ac
+}
+
+// TryNewPineconeAdminClient is NewPineconeAdminClient for callers that report
+// failures themselves, such as pc doctor. It returns an unauthenticated error
+// when neither a user token nor service account credentials are available.
+func TryNewPineconeAdminClient(ctx context.Context) (*pinecone.AdminClient, error) {
+	if local.Enabled() {
+		return nil, clierr.New(clierr.CodeUsage, "the admin API is not available with Pinecone Local")
+	}
+
 	oauth2Token, err := oauth.Token(ctx)
 	if err != nil {
 		log.Error().Err(err).Msg("Error retrieving oauth token")
 	}
-	clientId := secrets.ClientId.Get()
-	clientSecret := secrets.ClientSecret.Get()
+	clientId := secrets.GetClientId()
+	clientSecret := secrets.GetClientSecret()
 
 	// AdminClient can accept either user token or service account credentials
 	// If both are provided, the client will use the user token
 	if oauth2Token == nil || oauth2Token.AccessToken == "" && (clientId == "" || clientSecret == "") {
-		msg.FailMsg("Please login with %s or configure credentials with %s before attempting this operation.", style.Code("pc auth login"), style.Code("pc auth configure"))
-		exit.ErrorMsg("User is not logged in")
+		return nil, clierr.New(clierr.CodeUnauthenticated, "not logged in")
 	}
 
 	sourceTag := cliSourceTag()
-	ac, err := pinecone.NewAdminClient(pinecone.NewAdminClientParams{
+	return pinecone.NewAdminClient(pinecone.NewAdminClientParams{
 		ClientId:     clientId,
 		ClientSecret: clientSecret,
 		AccessToken:  oauth2Token.AccessToken,
 		SourceTag:    &sourceTag,
 		Host:         getPineconeHostURL(),
+		RestClient:   newRestClient(),
 	})
-	if err != nil {
-		msg.FailMsg("Failed to create Pinecone admin client: %s", err)
-		exit.Error(err, "Failed to create Pinecone admin client")
+}
+
+// newRestClient returns the HTTP client used for REST calls made by the SDK.
+// It applies the proxy and TLS settings, records the request ID of failed
+// responses so errors can report it, and dumps traffic for --debug and
+// --trace-http.
+func newRestClient() *http.Client {
+	var base http.RoundTripper = &clierr.RequestIDTransport{Base: transport.Default()}
+	if local.Enabled() {
+		base = &local.Transport{Base: base}
 	}
+	return &http.Client{Transport: &tracing.Transport{Base: base}}
+}
 
-	return ac
+// grpcDialOptions returns the options for data plane gRPC connections.
+func grpcDialOptions() []grpc.DialOption {
+	opts := []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor()),
+	}
+	// Pinecone Local is plaintext, so TLS and proxy settings don't apply
+	if local.Enabled() {
+		return opts
+	}
+	return append(opts, transport.GRPCDialOptions()...)
 }
 
 func NewIndexConnection(ctx context.Context, pc *pinecone.Client, indexName, namespace string) (*pinecone.IndexConnection, error) {
-	index, err := pc.DescribeIndex(ctx, indexName)
+	index, err := DescribeIndex(ctx, pc, indexName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe index: %w", err)
 	}
 
-	// Use private_host for BYOC if it exists
-	host := index.Host
-	if index.PrivateHost != nil {
-		host = *index.PrivateHost
-	}
+	host := indexHost(index)
 
 	ic, err := pc.Index(pinecone.NewIndexConnParams{
 		Host:      host,
 		Namespace: namespace,
-	})
+	}, grpcDialOptions()...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create index connection: %w", err)
 	}
@@ -177,49 +322,87 @@ func NewIndexConnection(ctx context.Context, pc *pinecone.Client, indexName, nam
 	return ic.WithNamespace(namespace), nil
 }
 
+// indexHost returns the data plane host for index, applying the BYOC private
+// host and the environment's index host overrides.
+func indexHost(index *pinecone.Index) string {
+	// Use private_host for BYOC if it exists
+	host := index.Host
+	if index.PrivateHost != nil {
+		host = *index.PrivateHost
+	}
+	// Custom environments may route every index through a fixed host
+	if envConfig, err := environment.GetEnvConfig(config.GetEnvironment()); err == nil && envConfig.IndexHost != "" {
+		host = envConfig.IndexHostFor(index.Name)
+	}
+	// The http:// scheme makes the SDK dial the emulator without TLS
+	if local.Enabled() {
+		host = local.DataHost(host)
+	}
+
+	return host
+}
+
+// getCLIAPIKeyForProject returns the CLI managed API key for the project, creating
+// one if none is stored. The secrets file stays locked while the key is created so
+// that concurrent pc processes don't each create a key and leak all but one.
 func getCLIAPIKeyForProject(ctx context.Context, ac *pinecone.AdminClient, project *pinecone.Project) (string, error) {
-	projectAPIKeysMap := secrets.ManagedAPIKeys.Get()
+	// If we have a managed key stored for the project, use the value
+	if managedKey, ok := secrets.GetProjectManagedKey(project.Id); ok && managedKey.Value != "" {
+		return managedKey.Value, nil
+	}
+
 	var managedKey secrets.ManagedKey
+	err := secrets.ManagedAPIKeys.TryUpdate(func(projectAPIKeysMap *map[string]secrets.ManagedKey) error {
+		if *projectAPIKeysMap == nil {
+			*projectAPIKeysMap = map[string]secrets.ManagedKey{}
+		}
 
-	// If we have a managed key stored for the project, use the value
-	managedKey, mkExists := projectAPIKeysMap[project.Id]
-	if mkExists {
-		managedKey = projectAPIKeysMap[project.Id]
-		if managedKey.Value != "" {
-			return managedKey.Value, nil
+		// Another pc process may have stored a key while we waited for the lock
+		if existing, ok := (*projectAPIKeysMap)[project.Id]; ok && existing.Value != "" {
+			managedKey = existing
+			return nil
+		}
+
+		// If we don't have a managed key at this point, we need to create a new one
+		newKeyName := generateCLIAPIKeyName()
+		newKey, err := ac.APIKey.Create(ctx, project.Id, &pinecone.CreateAPIKeyParams{
+			Name:  newKeyName,
+			Roles: &[]string{"ProjectEditor"},
+		})
+		if err != nil {
+			msg.FailMsg("Failed to create a CLI managed API key for project %s: %s", style.Emphasis(project.Name), err)
+			return fmt.Errorf("failed to create a CLI managed API key for project: %w", err)
+		}
+
+		managedKey = secrets.ManagedKey{
+			ProjectId:      project.Id,
+			ProjectName:    project.Name,
+			OrganizationId: project.OrganizationId,
+			Value:          newKey.Value,
+			Name:           newKeyName,
+			Id:             newKey.Key.Id,
+			Origin:         secrets.OriginCLICreated,
+			CreatedAt:      time.Now().UTC(),
 		}
-	}
 
-	// If we don't have a managed key at this point, we need to create a new one
-	newKeyName := generateCLIAPIKeyName()
-	newKey, err := ac.APIKey.Create(ctx, project.Id, &pinecone.CreateAPIKeyParams{
-		Name:  newKeyName,
-		Roles: &[]string{"ProjectEditor"},
+		// Add the new ManagedKey to the map
+		(*projectAPIKeysMap)[project.Id] = managedKey
+		return nil
 	})
 	if err != nil {
-		msg.FailMsg("Failed to create a CLI managed API key for project %s: %s", style.Emphasis(project.Name), err)
-		return "", fmt.Errorf("failed to create a CLI managed API key for project: %w", err)
+		return "", err
 	}
 
-	managedKey = secrets.ManagedKey{
-		ProjectId:      project.Id,
-		ProjectName:    project.Name,
-		OrganizationId: project.OrganizationId,
-		Value:          newKey.Value,
-		Name:           newKeyName,
-		Id:             newKey.Key.Id,
-		Origin:         secrets.OriginCLICreated,
-	}
-
-	// Add the new ManagedKey to the map
-	projectAPIKeysMap[project.Id] = managedKey
-	secrets.ManagedAPIKeys.Set(projectAPIKeysMap)
-
 	return managedKey.Value, nil
 }
 
+// APIHostURL returns the Pinecone API host for the configured environment.
+func APIHostURL() string {
+	return getPineconeHostURL()
+}
+
 func getPineconeHostURL() string {
-	env := config.Environment.Get()
+	env := config.GetEnvironment()
 	connectionConfig, err := environment.GetEnvConfig(env)
 	if err != nil { // If there's an error resolving the environment, default to production host
 		return environment.Prod.PineconeGCPURL
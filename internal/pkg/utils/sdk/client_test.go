@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"golang.org/x/oauth2"
+)
 
 func Test_cliSourceTag(t *testing.T) {
 	t.Run("returns default when env unset", func(t *testing.T) {
@@ -18,3 +22,29 @@ func Test_cliSourceTag(t *testing.T) {
 		}
 	})
 }
+
+func TestResolveCredentialSource(t *testing.T) {
+	token := &oauth2.Token{AccessToken: "token"}
+	tests := []struct {
+		name         string
+		apiKey       string
+		token        *oauth2.Token
+		clientId     string
+		clientSecret string
+		want         CredentialSource
+	}{
+		{name: "nothing configured", want: CredentialsNone},
+		{name: "empty token", token: &oauth2.Token{}, want: CredentialsNone},
+		{name: "api key wins over everything", apiKey: "key", token: token, clientId: "id", clientSecret: "secret", want: CredentialsAPIKey},
+		{name: "user token wins over service account", token: token, clientId: "id", clientSecret: "secret", want: CredentialsUserToken},
+		{name: "service account", clientId: "id", clientSecret: "secret", want: CredentialsServiceAccount},
+		{name: "incomplete service account", clientId: "id", want: CredentialsNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveCredentialSource(tt.apiKey, tt.token, tt.clientId, tt.clientSecret); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// IndexConnectionCache keeps one connection per index for long-running
+// commands, such as pc serve and pc mcp serve, so that each request doesn't
+// pay for DescribeIndex and a new gRPC connection. Namespaces share their
+// index's connection.
+type IndexConnectionCache struct {
+	connect func(ctx context.Context, indexName string) (*pinecone.IndexConnection, error)
+
+	mu    sync.Mutex
+	conns map[string]*indexConnEntry
+}
+
+// indexConnEntry is a connection, ready once the first caller has described
+// the index and dialed it.
+type indexConnEntry struct {
+	ready chan struct{}
+	ic    *pinecone.IndexConnection
+	err   error
+}
+
+func NewIndexConnectionCache(pc *pinecone.Client) *IndexConnectionCache {
+	return &IndexConnectionCache{
+		connect: func(ctx context.Context, indexName string) (*pinecone.IndexConnection, error) {
+			return NewIndexConnection(ctx, pc, indexName, "")
+		},
+		conns: map[string]*indexConnEntry{},
+	}
+}
+
+// Get returns the cached connection to indexName, targeting namespace. The
+// first caller for an index creates the connection with NewIndexConnection
+// while callers for other indexes proceed; a failure isn't cached, so the
+// next call tries again.
+func (c *IndexConnectionCache) Get(ctx context.Context, indexName, namespace string) (*pinecone.IndexConnection, error) {
+	if indexName == "" {
+		return nil, fmt.Errorf("index name is required")
+	}
+
+	c.mu.Lock()
+	e, ok := c.conns[indexName]
+	if !ok {
+		e = &indexConnEntry{ready: make(chan struct{})}
+		c.conns[indexName] = e
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		e.ic, e.err = c.connect(ctx, indexName)
+		if e.err != nil {
+			c.mu.Lock()
+			delete(c.conns, indexName)
+			c.mu.Unlock()
+		}
+		close(e.ready)
+	}
+
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.ic.WithNamespace(namespace), nil
+}
+
+// Close closes every cached connection, waiting for those being created.
+func (c *IndexConnectionCache) Close() {
+	c.mu.Lock()
+	entries := c.conns
+	c.conns = map[string]*indexConnEntry{}
+	c.mu.Unlock()
+
+	for _, e := range entries {
+		<-e.ready
+		if e.err == nil {
+			_ = e.ic.Close()
+		}
+	}
+}
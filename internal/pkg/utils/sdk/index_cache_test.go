@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndexConnectionCache(connect func(ctx context.Context, indexName string) (*pinecone.IndexConnection, error)) *IndexConnectionCache {
+	return &IndexConnectionCache{connect: connect, conns: map[string]*indexConnEntry{}}
+}
+
+func TestIndexConnectionCache_SharesConnectionAcrossNamespaces(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestIndexConnectionCache(func(context.Context, string) (*pinecone.IndexConnection, error) {
+		calls.Add(1)
+		return &pinecone.IndexConnection{}, nil
+	})
+
+	var wg sync.WaitGroup
+	for _, ns := range []string{"a", "b", "c", "a", ""} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ic, err := c.Get(context.Background(), "my-index", ns)
+			assert.NoError(t, err)
+			assert.Equal(t, ns, ic.Namespace())
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, calls.Load())
+	assert.Len(t, c.conns, 1)
+}
+
+func TestIndexConnectionCache_SlowIndexDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	c := newTestIndexConnectionCache(func(ctx context.Context, indexName string) (*pinecone.IndexConnection, error) {
+		if indexName == "slow" {
+			<-release
+		}
+		return &pinecone.IndexConnection{}, nil
+	})
+	defer close(release)
+
+	go func() { _, _ = c.Get(context.Background(), "slow", "") }()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := c.Get(context.Background(), "fast", "")
+		assert.NoError(t, err)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Get for another index waited for the slow one")
+	}
+
+	// Callers for the slow index give up with their context.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	for {
+		c.mu.Lock()
+		_, started := c.conns["slow"]
+		c.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	_, err := c.Get(ctx, "slow", "")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestIndexConnectionCache_RetriesFailures(t *testing.T) {
+	fail := true
+	c := newTestIndexConnectionCache(func(context.Context, string) (*pinecone.IndexConnection, error) {
+		if fail {
+			return nil, errors.New("describe failed")
+		}
+		return &pinecone.IndexConnection{}, nil
+	})
+
+	_, err := c.Get(context.Background(), "my-index", "")
+	assert.EqualError(t, err, "describe failed")
+
+	fail = false
+	ic, err := c.Get(context.Background(), "my-index", "ns")
+	require.NoError(t, err)
+	assert.Equal(t, "ns", ic.Namespace())
+
+	_, err = c.Get(context.Background(), "", "")
+	assert.Error(t, err)
+}
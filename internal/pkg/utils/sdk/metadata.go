@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/state"
+	"github.com/pinecone-io/cli/internal/pkg/utils/local"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// metadataTTL is how long described indexes and projects are reused before
+// the API is asked again. Commands that change them invalidate the entry.
+const metadataTTL = 5 * time.Minute
+
+var metadata = sync.OnceValue(func() *cache.Store {
+	return cache.Open("metadata", metadataTTL)
+})
+
+// CacheScope identifies the environment and credentials that cached data
+// plane values belong to, so that switching projects or API keys never reuses
+// another project's indexes.
+func CacheScope() string {
+	env := cacheEnvironment()
+	if local.Enabled() {
+		return env
+	}
+	if apiKey := secrets.GetDefaultAPIKey(); apiKey != "" {
+		sum := sha256.Sum256([]byte(apiKey))
+		return env + "/key/" + hex.EncodeToString(sum[:8])
+	}
+	project, _ := state.ResolveTargetProject()
+	return env + "/project/" + project.Id
+}
+
+// cacheEnvironment is the part of CacheScope shared by every credential of
+// the current environment.
+func cacheEnvironment() string {
+	if local.Enabled() {
+		return "local/" + local.Host()
+	}
+	return config.GetEnvironment()
+}
+
+func indexCacheKey(name string) string {
+	return CacheScope() + "/index/" + name
+}
+
+func projectCacheKey(projectId string) string {
+	return config.GetEnvironment() + "/admin/project/" + projectId
+}
+
+// DescribeIndex describes an index, reusing a recent result from the metadata
+// cache. Only ready indexes are cached, since the host and spec of an index
+// that is still initializing may change.
+func DescribeIndex(ctx context.Context, pc *pinecone.Client, name string) (*pinecone.Index, error) {
+	key := indexCacheKey(name)
+	var cached pinecone.Index
+	if fresh, ok := metadata().Get(key, &cached); ok && fresh && cached.Host != "" {
+		log.Debug().Str("index", name).Msg("Using cached index description")
+		return &cached, nil
+	}
+
+	index, err := pc.DescribeIndex(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if index.Status != nil && index.Status.Ready {
+		putMetadata(key, index)
+	}
+	return index, nil
+}
+
+// InvalidateIndex drops the cached description of an index after it is
+// created, configured or deleted. The same index may be cached under an API
+// key and a login for its project, so it is dropped from every scope of the
+// current environment.
+func InvalidateIndex(name string) {
+	deleteMetadata(indexKeyMatcher(cacheEnvironment(), name))
+}
+
+// indexKeyMatcher matches the cache keys of index name in any scope of env.
+func indexKeyMatcher(env, name string) func(key string) bool {
+	prefix, suffix := env+"/", "/index/"+name
+	return func(k string) bool {
+		return strings.HasPrefix(k, prefix) && strings.HasSuffix(k, suffix)
+	}
+}
+
+// describeProject describes a project, reusing a recent result from the
+// metadata cache.
+func describeProject(ctx context.Context, ac *pinecone.AdminClient, projectId string) (*pinecone.Project, error) {
+	key := projectCacheKey(projectId)
+	var cached pinecone.Project
+	if fresh, ok := metadata().Get(key, &cached); ok && fresh && cached.Id == projectId {
+		log.Debug().Str("project", projectId).Msg("Using cached project description")
+		return &cached, nil
+	}
+
+	project, err := ac.Project.Describe(ctx, projectId)
+	if err != nil {
+		return nil, err
+	}
+	putMetadata(key, project)
+	return project, nil
+}
+
+// InvalidateProject drops the cached description of a project and its
+// indexes after the project is updated or deleted.
+func InvalidateProject(projectId string) {
+	key := projectCacheKey(projectId)
+	indexPrefix := config.GetEnvironment() + "/project/" + projectId + "/"
+	deleteMetadata(func(k string) bool { return k == key || strings.HasPrefix(k, indexPrefix) })
+}
+
+func putMetadata(key string, v any) {
+	if err := metadata().Put(key, v); err != nil {
+		log.Debug().Err(err).Msg("Error writing metadata cache")
+	}
+}
+
+func deleteMetadata(match func(key string) bool) {
+	if err := metadata().Delete(match); err != nil {
+		log.Debug().Err(err).Msg("Error writing metadata cache")
+	}
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/cache"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// Cached descriptions must decode back to the same value, or data plane
+// commands would connect with a partial index after a cache hit.
+func TestIndexRoundTripsThroughCache(t *testing.T) {
+	dim := int32(1536)
+	private := "idx-abc.private.svc.pinecone.io"
+	want := &pinecone.Index{
+		Name:               "idx",
+		Host:               "idx-abc.svc.pinecone.io",
+		PrivateHost:        &private,
+		Metric:             pinecone.Cosine,
+		VectorType:         "dense",
+		DeletionProtection: pinecone.DeletionProtectionDisabled,
+		Dimension:          &dim,
+		Spec: &pinecone.IndexSpec{
+			Serverless: &pinecone.ServerlessSpec{Cloud: pinecone.Aws, Region: "us-east-1"},
+		},
+		Status: &pinecone.IndexStatus{Ready: true, State: pinecone.Ready},
+	}
+
+	store := cache.NewStore(filepath.Join(t.TempDir(), "metadata.json"), time.Minute)
+	if err := store.Put("k", want); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	var got pinecone.Index
+	if fresh, ok := store.Get("k", &got); !ok || !fresh {
+		t.Fatalf("Get fresh=%v ok=%v", fresh, ok)
+	}
+	if !reflect.DeepEqual(want, &got) {
+		t.Errorf("round trip mismatch:\nwant %+v\ngot  %+v", want, &got)
+	}
+}
+
+func TestInvalidateIndexMatchesEveryScope(t *testing.T) {
+	store := cache.NewStore(filepath.Join(t.TempDir(), "metadata.json"), time.Minute)
+	keys := []string{
+		"production/key/0123456789abcdef/index/docs",
+		"production/project/proj-1/index/docs",
+		"production/project/proj-1/index/docs-v2",
+		"production/admin/project/proj-1",
+		"staging/project/proj-1/index/docs",
+	}
+	for _, k := range keys {
+		if err := store.Put(k, pinecone.Index{Name: "docs"}); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+
+	if err := store.Delete(indexKeyMatcher("production", "docs")); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	for i, k := range keys {
+		var got pinecone.Index
+		_, ok := store.Get(k, &got)
+		if want := i >= 2; ok != want {
+			t.Errorf("%s cached = %v, want %v", k, ok, want)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/secrets"
+	"github.com/pinecone-io/cli/internal/pkg/utils/log"
+	"github.com/pinecone-io/go-pinecone/v5/pinecone"
+)
+
+// A freshly created key can take a moment to become usable, so verification
+// is retried before the rotation is rolled back.
+var (
+	verifyKeyAttempts = 5
+	verifyKeyDelay    = 2 * time.Second
+)
+
+// RotateManagedKey replaces a managed API key. It creates a new key with the
+// same roles, stores it in place of the old one, verifies it with a cheap
+// control plane call, and then deletes the old key. If verification fails the
+// old key is restored locally and the new key is deleted.
+//
+// When the old key cannot be deleted the rotation has still taken effect, so
+// the new key is returned along with the error.
+func RotateManagedKey(ctx context.Context, ac *pinecone.AdminClient, old secrets.ManagedKey) (secrets.ManagedKey, error) {
+	roles := []string{"ProjectEditor"}
+	if described, err := ac.APIKey.Describe(ctx, old.Id); err == nil && len(described.Roles) > 0 {
+		roles = described.Roles
+	}
+
+	name := old.Name
+	if old.Origin == secrets.OriginCLICreated || name == "" {
+		name = generateCLIAPIKeyName()
+	}
+
+	created, err := ac.APIKey.Create(ctx, old.ProjectId, &pinecone.CreateAPIKeyParams{
+		Name:  name,
+		Roles: &roles,
+	})
+	if err != nil {
+		return secrets.ManagedKey{}, fmt.Errorf("failed to create replacement key: %w", err)
+	}
+
+	replacement := old
+	replacement.Name = created.Key.Name
+	replacement.Id = created.Key.Id
+	replacement.Value = created.Value
+	replacement.CreatedAt = time.Now().UTC()
+	secrets.SetProjectManagedKey(replacement)
+
+	if err := verifyAPIKey(ctx, replacement.Value); err != nil {
+		log.Error().Err(err).Str("project_id", old.ProjectId).Msg("Replacement key failed verification, rolling back")
+		secrets.SetProjectManagedKey(old)
+		if delErr := ac.APIKey.Delete(ctx, replacement.Id); delErr != nil {
+			log.Error().Err(delErr).Str("key_id", replacement.Id).Msg("Failed to delete unverified replacement key")
+		}
+		return secrets.ManagedKey{}, fmt.Errorf("replacement key failed verification: %w", err)
+	}
+
+	if err := ac.APIKey.Delete(ctx, old.Id); err != nil {
+		return replacement, fmt.Errorf("rotated to key %s but failed to delete old key %s: %w", replacement.Id, old.Id, err)
+	}
+	return replacement, nil
+}
+
+func verifyAPIKey(ctx context.Context, apiKey string) error {
+	pc := NewClientForAPIKey(apiKey)
+	var err error
+	for attempt := 1; attempt <= verifyKeyAttempts; attempt++ {
+		if _, err = pc.ListIndexes(ctx); err == nil {
+			return nil
+		}
+		log.Debug().Err(err).Int("attempt", attempt).Msg("Verifying replacement API key")
+		if attempt < verifyKeyAttempts {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(verifyKeyDelay):
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,268 @@
+// Package tracing dumps the HTTP and gRPC traffic of the Pinecone SDK clients
+// for --debug and --trace-http. Credentials are redacted before anything is
+// written, so a trace file can be attached to a support ticket.
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxBodyBytes caps how much of each request and response body is dumped.
+const MaxBodyBytes = 64 * 1024
+
+// Redacted replaces secrets in trace output.
+const Redacted = "[REDACTED]"
+
+var (
+	mu  sync.Mutex
+	out io.Writer
+)
+
+// Enable starts tracing to w. Passing nil disables tracing.
+func Enable(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	out = w
+}
+
+// Enabled reports whether traffic is being traced.
+func Enabled() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return out != nil
+}
+
+// OpenFile opens path for appending trace output, creating it if needed. The
+// file is only readable by the current user.
+func OpenFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+}
+
+// write prints one trace entry atomically so concurrent requests don't
+// interleave.
+func write(entry string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if out == nil {
+		return
+	}
+	fmt.Fprint(out, entry)
+}
+
+// Transport is an http.RoundTripper that dumps each request and response when
+// tracing is enabled. It is a no-op pass-through otherwise.
+type Transport struct {
+	Base http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if !Enabled() {
+		return base.RoundTrip(req)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "> %s %s\n", req.Method, RedactURL(req.URL.String()))
+	writeHeaders(&b, ">", req.Header)
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		writeBody(&b, ">", body)
+	}
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	elapsed := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(&b, "< error after %s: %s\n\n", elapsed, err)
+		write(b.String())
+		return nil, err
+	}
+
+	fmt.Fprintf(&b, "< %s (%s)", resp.Status, elapsed)
+	if id := resp.Header.Get("X-Pinecone-Request-Id"); id != "" {
+		fmt.Fprintf(&b, " request-id=%s", id)
+	}
+	b.WriteString("\n")
+	writeHeaders(&b, "<", resp.Header)
+	if resp.Body != nil {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		if readErr != nil {
+			fmt.Fprintf(&b, "< error reading body: %s\n", readErr)
+		}
+		writeBody(&b, "<", body)
+	}
+	b.WriteString("\n")
+	write(b.String())
+	return resp, nil
+}
+
+func writeHeaders(b *strings.Builder, prefix string, h http.Header) {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range h[name] {
+			fmt.Fprintf(b, "%s %s: %s\n", prefix, name, RedactHeader(name, value))
+		}
+	}
+}
+
+func writeBody(b *strings.Builder, prefix string, body []byte) {
+	if len(body) == 0 {
+		return
+	}
+	truncated := len(body) > MaxBodyBytes
+	if truncated {
+		body = body[:MaxBodyBytes]
+	}
+	for _, line := range strings.Split(strings.TrimRight(RedactBody(string(body)), "\n"), "\n") {
+		fmt.Fprintf(b, "%s %s\n", prefix, line)
+	}
+	if truncated {
+		fmt.Fprintf(b, "%s [truncated after %d bytes]\n", prefix, MaxBodyBytes)
+	}
+}
+
+// UnaryClientInterceptor dumps unary gRPC calls, such as queries and upserts.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !Enabled() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "> gRPC %s%s\n", cc.Target(), method)
+		if md, ok := metadata.FromOutgoingContext(ctx); ok {
+			writeMetadata(&b, ">", md)
+		}
+		writeMessage(&b, ">", req)
+
+		var header metadata.MD
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		elapsed := time.Since(start).Round(time.Millisecond)
+
+		fmt.Fprintf(&b, "< %s (%s)", status.Code(err), elapsed)
+		if ids := header.Get("x-pinecone-request-id"); len(ids) > 0 {
+			fmt.Fprintf(&b, " request-id=%s", ids[0])
+		}
+		b.WriteString("\n")
+		if err != nil {
+			fmt.Fprintf(&b, "< %s\n", status.Convert(err).Message())
+		} else {
+			writeMessage(&b, "<", reply)
+		}
+		b.WriteString("\n")
+		write(b.String())
+		return err
+	}
+}
+
+// StreamClientInterceptor logs the start and outcome of streaming gRPC calls.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !Enabled() {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		start := time.Now()
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		write(fmt.Sprintf("> gRPC stream %s%s\n< %s (%s)\n\n", cc.Target(), method, status.Code(err), time.Since(start).Round(time.Millisecond)))
+		return stream, err
+	}
+}
+
+func writeMetadata(b *strings.Builder, prefix string, md metadata.MD) {
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range md[k] {
+			fmt.Fprintf(b, "%s %s: %s\n", prefix, k, RedactHeader(k, v))
+		}
+	}
+}
+
+func writeMessage(b *strings.Builder, prefix string, msg any) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	body, err := protojson.Marshal(m)
+	if err != nil {
+		return
+	}
+	writeBody(b, prefix, body)
+}
+
+var sensitiveHeaders = map[string]bool{
+	"api-key":             true,
+	"authorization":       true,
+	"proxy-authorization": true,
+	"cookie":              true,
+	"set-cookie":          true,
+	"x-api-key":           true,
+}
+
+// RedactHeader returns value, or Redacted when the header carries credentials.
+func RedactHeader(name, value string) string {
+	if sensitiveHeaders[strings.ToLower(name)] {
+		return Redacted
+	}
+	return RedactBody(value)
+}
+
+var (
+	// JSON string fields holding credentials. "value" is the secret of an API
+	// key in create responses, whatever format the key has, so it is redacted
+	// everywhere rather than only when it looks like a key.
+	sensitiveJSONField = regexp.MustCompile(`("(?:access_token|refresh_token|id_token|client_secret|api_key|apiKey|secret|password|device_code|value)"\s*:\s*)"[^"]*"`)
+	// Form-encoded credentials, as sent to OAuth token endpoints.
+	sensitiveFormField = regexp.MustCompile(`\b((?:access_token|refresh_token|id_token|client_secret|api_key|password|code_verifier|device_code|code)=)[^&\s]*`)
+	// Pinecone API keys and JWTs wherever they appear.
+	apiKeyValue = regexp.MustCompile(`pcsk_[A-Za-z0-9_-]+`)
+	jwtValue    = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`)
+)
+
+// RedactBody removes credentials from a request or response body.
+func RedactBody(body string) string {
+	body = sensitiveJSONField.ReplaceAllString(body, `${1}"`+Redacted+`"`)
+	body = sensitiveFormField.ReplaceAllString(body, "${1}"+Redacted)
+	body = apiKeyValue.ReplaceAllString(body, Redacted)
+	body = jwtValue.ReplaceAllString(body, Redacted)
+	return body
+}
+
+// RedactURL removes credentials passed as query parameters.
+func RedactURL(u string) string {
+	return sensitiveFormField.ReplaceAllString(u, "${1}"+Redacted)
+}
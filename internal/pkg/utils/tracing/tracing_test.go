@@ -0,0 +1,118 @@
+package tracing
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactHeader(t *testing.T) {
+	assert.Equal(t, Redacted, RedactHeader("Api-Key", "pcsk_abc123"))
+	assert.Equal(t, Redacted, RedactHeader("authorization", "Bearer token"))
+	assert.Equal(t, "application/json", RedactHeader("Content-Type", "application/json"))
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "json token fields",
+			in:   `{"access_token": "abc", "token_type": "Bearer", "refresh_token":"def"}`,
+			want: `{"access_token": "[REDACTED]", "token_type": "Bearer", "refresh_token":"[REDACTED]"}`,
+		},
+		{
+			name: "form fields",
+			in:   "grant_type=client_credentials&client_id=id&client_secret=s3cr3t",
+			want: "grant_type=client_credentials&client_id=id&client_secret=[REDACTED]",
+		},
+		{
+			name: "api key values",
+			in:   `{"key": {"id": "k1"}, "value": "pcsk_2abc_XYZ"}`,
+			want: `{"key": {"id": "k1"}, "value": "[REDACTED]"}`,
+		},
+		{
+			name: "api key values in any format",
+			in:   `{"key": {"id": "k1", "roles": ["ProjectEditor"]}, "value":"legacy-5f2c1a9e"}`,
+			want: `{"key": {"id": "k1", "roles": ["ProjectEditor"]}, "value":"[REDACTED]"}`,
+		},
+		{
+			name: "non-string values",
+			in:   `{"values": [0.1, 0.2], "value": 3}`,
+			want: `{"values": [0.1, 0.2], "value": 3}`,
+		},
+		{
+			name: "jwt",
+			in:   "token eyJhbGciOi.eyJzdWIiOi.c2lnbmF0dXJl end",
+			want: "token [REDACTED] end",
+		},
+		{
+			name: "nothing sensitive",
+			in:   `{"name": "my-index", "dimension": 1536}`,
+			want: `{"name": "my-index", "dimension": 1536}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RedactBody(tt.in))
+		})
+	}
+}
+
+func TestTransport_DumpsRedactedTraffic(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"my-index"}`, string(body))
+		w.Header().Set("X-Pinecone-Request-Id", "req-123")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"name":"my-index","status":"Initializing"}`))
+	}))
+	defer srv.Close()
+
+	var buf bytes.Buffer
+	Enable(&buf)
+	defer Enable(nil)
+
+	client := &http.Client{Transport: &Transport{}}
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/indexes", strings.NewReader(`{"name":"my-index"}`))
+	require.NoError(t, err)
+	req.Header.Set("Api-Key", "pcsk_secret")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":"my-index","status":"Initializing"}`, string(body))
+
+	out := buf.String()
+	assert.Contains(t, out, "> POST "+srv.URL+"/indexes")
+	assert.Contains(t, out, "> Api-Key: [REDACTED]")
+	assert.Contains(t, out, `> {"name":"my-index"}`)
+	assert.Contains(t, out, "< 201 Created")
+	assert.Contains(t, out, "request-id=req-123")
+	assert.Contains(t, out, `< {"name":"my-index","status":"Initializing"}`)
+	assert.NotContains(t, out, "pcsk_secret")
+}
+
+func TestTransport_DisabledPassesThrough(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	Enable(nil)
+	resp, err := (&http.Client{Transport: &Transport{}}).Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.False(t, Enabled())
+}
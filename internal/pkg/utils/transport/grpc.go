@@ -0,0 +1,146 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/resolver"
+)
+
+// GRPCDialOptions returns dial options that apply the current settings to
+// data plane connections. It returns nothing when the gRPC defaults apply.
+func GRPCDialOptions() []grpc.DialOption {
+	s := CurrentSettings()
+	tlsConfig, err := s.TLSConfig()
+	if err != nil {
+		failInvalidSettings(err)
+	}
+
+	var opts []grpc.DialOption
+	if tlsConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	// gRPC honours HTTPS_PROXY and NO_PROXY itself, but not the proxy and
+	// no-proxy keys.
+	if s.Proxy != "" || s.NoProxy != "" {
+		// Leave name resolution to the proxy; the network may have no
+		// external DNS.
+		opts = append(opts,
+			grpc.WithResolvers(passthroughResolver{resolver.Get("passthrough")}),
+			grpc.WithContextDialer(proxyDialer(s, tlsConfig)))
+	}
+	return opts
+}
+
+// passthroughResolver hands a connection's target to its dialer unresolved,
+// like gRPC's passthrough resolver. It takes the place of the dns resolver
+// that gRPC uses for targets without a scheme, such as the SDK's
+// host:port, for that connection only.
+type passthroughResolver struct {
+	resolver.Builder
+}
+
+func (passthroughResolver) Scheme() string {
+	return "dns"
+}
+
+// proxyDialer connects to addr through an HTTP CONNECT tunnel when the proxy
+// settings call for one, and directly otherwise.
+func proxyDialer(s Settings, tlsConfig *tls.Config) func(context.Context, string) (net.Conn, error) {
+	proxyFunc := s.ProxyFunc()
+	return func(ctx context.Context, addr string) (net.Conn, error) {
+		var d net.Dialer
+		proxy, err := proxyFunc(&url.URL{Scheme: "https", Host: addr})
+		if err != nil {
+			return nil, err
+		}
+		if proxy == nil {
+			return d.DialContext(ctx, "tcp", addr)
+		}
+
+		proxyAddr := proxy.Host
+		if proxy.Port() == "" {
+			port := "80"
+			if proxy.Scheme == "https" {
+				port = "443"
+			}
+			proxyAddr = net.JoinHostPort(proxy.Hostname(), port)
+		}
+		conn, err := d.DialContext(ctx, "tcp", proxyAddr)
+		if err != nil {
+			return nil, fmt.Errorf("connecting to proxy %s: %w", proxy.Redacted(), err)
+		}
+		if proxy.Scheme == "https" {
+			cfg := &tls.Config{ServerName: proxy.Hostname()}
+			if tlsConfig != nil {
+				cfg.RootCAs = tlsConfig.RootCAs
+			}
+			conn = tls.Client(conn, cfg)
+		}
+
+		conn, err = connect(ctx, conn, proxy, addr)
+		if err != nil {
+			return nil, fmt.Errorf("proxy %s: %w", proxy.Redacted(), err)
+		}
+		return conn, nil
+	}
+}
+
+// connect asks the proxy on conn to open a tunnel to addr.
+func connect(ctx context.Context, conn net.Conn, proxy *url.URL, addr string) (net.Conn, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if proxy.User != nil {
+		password, _ := proxy.User.Password()
+		creds := base64.StdEncoding.EncodeToString([]byte(proxy.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+creds)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("CONNECT %s: %s", addr, resp.Status)
+	}
+	if br.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, r: br}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn returns bytes the proxy sent after its CONNECT response before
+// reading from the connection again.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.r.Read(p)
+}
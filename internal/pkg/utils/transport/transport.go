@@ -0,0 +1,182 @@
+// Package transport builds the HTTP and gRPC connection settings shared by
+// every client the CLI creates: a custom CA bundle, an mTLS client
+// certificate, and an HTTP(S) proxy, from the ca-bundle, client-cert,
+// client-key, proxy and no-proxy config keys.
+package transport
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/clierr"
+	"github.com/pinecone-io/cli/internal/pkg/utils/configuration/config"
+	"github.com/pinecone-io/cli/internal/pkg/utils/exit"
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Settings are the connection settings from config. Empty fields use the
+// defaults: the system CA pool, no client certificate, and the proxy from
+// HTTPS_PROXY, HTTP_PROXY and NO_PROXY.
+type Settings struct {
+	CABundle   string
+	ClientCert string
+	ClientKey  string
+	Proxy      string
+	NoProxy    string
+}
+
+// CurrentSettings returns the settings from config and their env vars.
+func CurrentSettings() Settings {
+	return Settings{
+		CABundle:   config.CABundle.Get(),
+		ClientCert: config.ClientCert.Get(),
+		ClientKey:  config.ClientKey.Get(),
+		Proxy:      config.Proxy.Get(),
+		NoProxy:    config.NoProxy.Get(),
+	}
+}
+
+// Validate reports whether s can be applied: the files exist and parse, and
+// the proxy is an http or https URL.
+func (s Settings) Validate() error {
+	if _, err := s.TLSConfig(); err != nil {
+		return err
+	}
+	if s.Proxy != "" {
+		if _, err := parseProxyURL(s.Proxy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TLSConfig returns the TLS settings for connections to Pinecone, or nil when
+// the Go defaults apply.
+func (s Settings) TLSConfig() (*tls.Config, error) {
+	if s.CABundle == "" && s.ClientCert == "" && s.ClientKey == "" {
+		return nil, nil
+	}
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if s.CABundle != "" {
+		// The bundle is added to the system roots, so public endpoints that
+		// bypass the proxy keep working.
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := os.ReadFile(s.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("reading ca-bundle: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("ca-bundle %s contains no PEM certificates", s.CABundle)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (s.ClientCert == "") != (s.ClientKey == "") {
+		return nil, fmt.Errorf("client-cert and client-key must be set together")
+	}
+	if s.ClientCert != "" {
+		cert, err := tls.LoadX509KeyPair(s.ClientCert, s.ClientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client-cert and client-key: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// ProxyFunc returns the proxy to use for a request URL: the proxy key, or
+// HTTPS_PROXY/HTTP_PROXY when it is unset, except for hosts matched by
+// no-proxy (or NO_PROXY when it is unset). Localhost is never proxied.
+func (s Settings) ProxyFunc() func(*url.URL) (*url.URL, error) {
+	cfg := httpproxy.FromEnvironment()
+	if s.Proxy != "" {
+		cfg.HTTPProxy, cfg.HTTPSProxy = s.Proxy, s.Proxy
+	}
+	if s.NoProxy != "" {
+		cfg.NoProxy = s.NoProxy
+	}
+	return cfg.ProxyFunc()
+}
+
+// ProxyFor returns the proxy used to reach rawURL, or nil for a direct
+// connection.
+func (s Settings) ProxyFor(rawURL string) *url.URL {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil
+	}
+	proxy, err := s.ProxyFunc()(u)
+	if err != nil {
+		return nil
+	}
+	return proxy
+}
+
+func parseProxyURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %q: %w", raw, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy %q; must be an http:// or https:// URL", raw)
+	}
+	return u, nil
+}
+
+// NewTransport returns an http.Transport with s applied.
+func (s Settings) NewTransport() (*http.Transport, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	tlsConfig, err := s.TLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	proxy := s.ProxyFunc()
+	t.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+	if tlsConfig != nil {
+		t.TLSClientConfig = tlsConfig
+	}
+	return t, nil
+}
+
+var (
+	defaultOnce      sync.Once
+	defaultTransport *http.Transport
+)
+
+// Default returns the transport for the current settings, shared by all
+// clients. Invalid settings are reported and the CLI exits with a usage error.
+func Default() http.RoundTripper {
+	defaultOnce.Do(func() {
+		t, err := CurrentSettings().NewTransport()
+		if err != nil {
+			failInvalidSettings(err)
+		}
+		defaultTransport = t
+	})
+	return defaultTransport
+}
+
+// HTTPClient returns an http.Client using Default.
+func HTTPClient() *http.Client {
+	return &http.Client{Transport: Default()}
+}
+
+func failInvalidSettings(err error) {
+	msg.FailMsg("Invalid connection settings: %s", err)
+	exit.Error(clierr.Wrap(clierr.CodeUsage, err), "Invalid connection settings")
+}
@@ -0,0 +1,253 @@
+// Package watch re-runs a describe or list command on an interval, redrawing
+// its output in place and highlighting the values that changed, until
+// everything it shows has reached a final state.
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pinecone-io/cli/internal/pkg/utils/msg"
+	"github.com/pinecone-io/cli/internal/pkg/utils/style"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// DefaultInterval is the default of the --interval flag.
+const DefaultInterval = 5 * time.Second
+
+const minInterval = time.Second
+
+// clearScreen moves the cursor home and clears the terminal.
+const clearScreen = "\x1b[H\x1b[2J"
+
+// Options holds the --watch and --interval flags.
+type Options struct {
+	Enabled  bool
+	Interval time.Duration
+	// Table is whether the output format is a table. Other formats print
+	// every refresh in full, one after another, without the header and
+	// changes summary.
+	Table bool
+}
+
+// AddFlags adds --watch and --interval to cmd.
+func AddFlags(cmd *cobra.Command, o *Options) {
+	cmd.Flags().BoolVar(&o.Enabled, "watch", false, "Refresh the output until everything reaches a final state (Ctrl-C to stop)")
+	cmd.Flags().DurationVar(&o.Interval, "interval", DefaultInterval, "Time between refreshes with --watch")
+}
+
+// Enabled reports whether --watch was given to cmd.
+func Enabled(cmd *cobra.Command) bool {
+	f := cmd.Flags().Lookup("watch")
+	return f != nil && f.Value.String() == "true"
+}
+
+type pollTimeoutKey struct{}
+
+// WithPollTimeout returns ctx carrying the --timeout to apply to each refresh.
+// Watching runs until interrupted, so the global timeout can't bound the
+// whole command.
+func WithPollTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, pollTimeoutKey{}, timeout)
+}
+
+// Value is a tracked value of a frame, such as an import's percent complete.
+// Changes between refreshes are listed below the output.
+type Value struct {
+	Key   string
+	Value string
+}
+
+// Frame is the result of one refresh.
+type Frame struct {
+	// Print writes the command's usual output to stdout.
+	Print func()
+	// Values are compared with the previous frame's values by Key.
+	Values []Value
+	// Done is whether everything shown has reached a final state.
+	Done bool
+}
+
+// Poll fetches a frame.
+type Poll func(ctx context.Context) (Frame, error)
+
+// Run prints the frame returned by poll. With o.Enabled, it refreshes the
+// frame every o.Interval until the frame is Done or ctx is cancelled.
+func Run(ctx context.Context, o Options, poll Poll) error {
+	if !o.Enabled {
+		frame, err := poll(ctx)
+		if err != nil {
+			return err
+		}
+		frame.Print()
+		return nil
+	}
+	if o.Interval < minInterval {
+		return fmt.Errorf("--interval must be at least %s", minInterval)
+	}
+
+	w := &watcher{
+		out:         os.Stdout,
+		options:     o,
+		interactive: o.Table && term.IsTerminal(int(os.Stdout.Fd())),
+	}
+	return w.run(ctx, poll)
+}
+
+type watcher struct {
+	out         io.Writer
+	options     Options
+	interactive bool
+	now         func() time.Time
+}
+
+func (w *watcher) run(ctx context.Context, poll Poll) error {
+	if w.now == nil {
+		w.now = time.Now
+	}
+	timeout, _ := ctx.Value(pollTimeoutKey{}).(time.Duration)
+
+	var last *Frame
+	for {
+		pollCtx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			pollCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		frame, err := poll(pollCtx)
+		cancel()
+
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil && last == nil:
+			return err
+		case err != nil:
+			// Keep showing the last frame; the next refresh may succeed.
+			w.draw(*last, nil, err)
+		default:
+			var previous []Value
+			if last != nil {
+				previous = last.Values
+			}
+			w.draw(frame, Changes(previous, frame.Values), nil)
+			last = &frame
+			if frame.Done {
+				if w.options.Table {
+					msg.SuccessMsg("Everything reached a final state")
+				}
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(w.options.Interval):
+		}
+	}
+}
+
+func (w *watcher) draw(frame Frame, changes []Change, pollErr error) {
+	if !w.options.Table {
+		if pollErr != nil {
+			msg.WarnMsg("Refresh failed: %s", pollErr)
+			return
+		}
+		frame.Print()
+		return
+	}
+
+	if w.interactive {
+		fmt.Fprint(w.out, clearScreen)
+	}
+	fmt.Fprintln(w.out, style.Faint(fmt.Sprintf("Every %s, last refresh %s. Press Ctrl-C to stop.", w.options.Interval, w.now().Format(time.TimeOnly))))
+	if pollErr != nil {
+		fmt.Fprintln(w.out, style.StatusRed(fmt.Sprintf("Refresh failed: %s", pollErr)))
+	}
+	fmt.Fprintln(w.out)
+	frame.Print()
+
+	if len(changes) > 0 {
+		fmt.Fprintln(w.out)
+		fmt.Fprintln(w.out, style.Heading("Changes since last refresh"))
+		for _, c := range changes {
+			fmt.Fprintf(w.out, "  %s: %s\n", c.Key, c.Render())
+		}
+	}
+	if !w.interactive {
+		fmt.Fprintln(w.out)
+	}
+}
+
+// Change is a tracked value that differs from the previous frame. Old is
+// empty for a value that is new in this frame.
+type Change struct {
+	Key string
+	Old string
+	New string
+}
+
+// Changes returns the values of current that were added or changed since
+// previous, in the order of current. It returns nil for the first frame.
+func Changes(previous, current []Value) []Change {
+	if previous == nil {
+		return nil
+	}
+	old := make(map[string]string, len(previous))
+	for _, v := range previous {
+		old[v.Key] = v.Value
+	}
+	var changes []Change
+	for _, v := range current {
+		if prev, ok := old[v.Key]; !ok || prev != v.Value {
+			changes = append(changes, Change{Key: v.Key, Old: prev, New: v.Value})
+		}
+	}
+	return changes
+}
+
+// Render formats the change as "old → new", with the difference for
+// numbers: green when the number grew, red when it shrank, and yellow for
+// any other change.
+func (c Change) Render() string {
+	if c.Old == "" {
+		return style.StatusYellow(c.New) + " " + style.Faint("(new)")
+	}
+	arrow := fmt.Sprintf("%s → ", c.Old)
+	oldNum, errOld := parseNumber(c.Old)
+	newNum, errNew := parseNumber(c.New)
+	if errOld != nil || errNew != nil {
+		return arrow + style.StatusYellow(c.New)
+	}
+	delta := strconv.FormatFloat(math.Round((newNum-oldNum)*100)/100, 'f', -1, 64)
+	if newNum >= oldNum {
+		return arrow + style.StatusGreen(fmt.Sprintf("%s (+%s)", c.New, delta))
+	}
+	return arrow + style.StatusRed(fmt.Sprintf("%s (%s)", c.New, delta))
+}
+
+func parseNumber(s string) (float64, error) {
+	s = strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "%")
+	if s == "" {
+		return 0, errors.New("empty")
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// IsFinal reports whether status is a final state of an index, backup,
+// import or restore job, after which it no longer changes on its own.
+func IsFinal(status string) bool {
+	switch strings.ToLower(status) {
+	case "ready", "completed", "failed", "cancelled", "canceled", "initializationfailed", "disabled":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,118 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChanges(t *testing.T) {
+	previous := []Value{{Key: "a status", Value: "InProgress"}, {Key: "a percent", Value: "10.0%"}}
+	current := []Value{{Key: "a status", Value: "InProgress"}, {Key: "a percent", Value: "25.5%"}, {Key: "b status", Value: "Pending"}}
+
+	assert.Nil(t, Changes(nil, current), "first frame has no changes")
+	assert.Equal(t, []Change{
+		{Key: "a percent", Old: "10.0%", New: "25.5%"},
+		{Key: "b status", New: "Pending"},
+	}, Changes(previous, current))
+}
+
+func TestChangeRender(t *testing.T) {
+	// Colors are off when stdout isn't a terminal.
+	tests := []struct {
+		change Change
+		want   string
+	}{
+		{Change{Old: "1,200", New: "1,500"}, "1,200 → 1,500 (+300)"},
+		{Change{Old: "10.0%", New: "25.5%"}, "10.0% → 25.5% (+15.5)"},
+		{Change{Old: "9", New: "4"}, "9 → 4 (-5)"},
+		{Change{Old: "InProgress", New: "Completed"}, "InProgress → Completed"},
+		{Change{New: "Pending"}, "Pending (new)"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.change.Render())
+	}
+}
+
+func TestIsFinal(t *testing.T) {
+	for _, s := range []string{"Ready", "Completed", "Failed", "Cancelled", "InitializationFailed"} {
+		assert.True(t, IsFinal(s), s)
+	}
+	for _, s := range []string{"Pending", "InProgress", "Initializing", "ScalingUp", "Terminating", ""} {
+		assert.False(t, IsFinal(s), s)
+	}
+}
+
+// fakePoll returns the statuses in order, failing where a status is "error".
+func fakePoll(out *bytes.Buffer, statuses ...string) Poll {
+	i := 0
+	return func(ctx context.Context) (Frame, error) {
+		status := statuses[min(i, len(statuses)-1)]
+		i++
+		if status == "error" {
+			return Frame{}, errors.New("unavailable")
+		}
+		return Frame{
+			Print:  func() { fmt.Fprintf(out, "STATUS %s\n", status) },
+			Values: []Value{{Key: "status", Value: status}},
+			Done:   IsFinal(status),
+		}, nil
+	}
+}
+
+func newTestWatcher(out *bytes.Buffer, table bool) *watcher {
+	return &watcher{
+		out:     out,
+		options: Options{Enabled: true, Interval: time.Millisecond, Table: table},
+		now:     func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) },
+	}
+}
+
+func TestWatcherRunStopsWhenDone(t *testing.T) {
+	var out bytes.Buffer
+	err := newTestWatcher(&out, true).run(context.Background(), fakePoll(&out, "Pending", "error", "InProgress", "Completed"))
+	require.NoError(t, err)
+
+	got := out.String()
+	assert.Equal(t, 4, strings.Count(got, "Every 1ms, last refresh 03:04:05."))
+	assert.Contains(t, got, "Refresh failed: unavailable")
+	assert.Contains(t, got, "status: Pending → InProgress")
+	assert.Contains(t, got, "status: InProgress → Completed")
+	assert.NotContains(t, got, clearScreen, "no redraw when stdout isn't a terminal")
+}
+
+func TestWatcherRunPlainFormats(t *testing.T) {
+	var out bytes.Buffer
+	err := newTestWatcher(&out, false).run(context.Background(), fakePoll(&out, "Pending", "Completed"))
+	require.NoError(t, err)
+	assert.Equal(t, "STATUS Pending\nSTATUS Completed\n", out.String())
+}
+
+func TestWatcherRunFirstPollError(t *testing.T) {
+	var out bytes.Buffer
+	err := newTestWatcher(&out, true).run(context.Background(), fakePoll(&out, "error"))
+	assert.EqualError(t, err, "unavailable")
+}
+
+func TestWatcherRunPollTimeout(t *testing.T) {
+	var out bytes.Buffer
+	ctx := WithPollTimeout(context.Background(), time.Minute)
+	err := newTestWatcher(&out, false).run(ctx, func(ctx context.Context) (Frame, error) {
+		_, ok := ctx.Deadline()
+		assert.True(t, ok, "each refresh has a deadline")
+		return Frame{Print: func() {}, Done: true}, nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRunRejectsShortInterval(t *testing.T) {
+	err := Run(context.Background(), Options{Enabled: true, Interval: 10 * time.Millisecond}, fakePoll(&bytes.Buffer{}, "Ready"))
+	assert.ErrorContains(t, err, "--interval must be at least 1s")
+}